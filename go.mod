@@ -2,4 +2,4 @@ module github.com/lebruchette/algos
 
 go 1.23.3
 
-require golang.org/x/exp v0.0.0-20250606033433-dcc06ee1d476 // indirect
+require golang.org/x/exp v0.0.0-20250606033433-dcc06ee1d476
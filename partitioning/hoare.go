@@ -29,3 +29,39 @@ func HoarePartition(elements []int) {
 	}
 
 }
+
+// HoarePartitionFunc partitions elements of any type around the value of elements[0], like HoarePartition, using a
+// three-way compare function that returns a negative number, zero or a positive number when a is less than, equal to
+// or greater than b, such as cmp.Compare or types.CompareFunc(comparator).  onSwap, if not nil, is called after each
+// exchange of two elements.
+//
+// It returns the index p of the last element of the left partition: every element of elements[:p+1] is less than or
+// equal to the pivot and every element of elements[p+1:] is greater than or equal to it.  For two or more elements both
+// partitions are non-empty, so p is in [0, len(elements)-2]; -1 is returned for an empty slice and 0 for a single
+// element.  It does not allocate.
+func HoarePartitionFunc[T any](elements []T, compare func(a, b T) int, onSwap func(i, j int)) int {
+	if len(elements) <= 1 {
+		return len(elements) - 1
+	}
+
+	i, j, pivot := 0, len(elements)-1, elements[0]
+	for {
+		for compare(elements[i], pivot) < 0 {
+			i++
+		}
+		for compare(elements[j], pivot) > 0 {
+			j--
+		}
+
+		if i >= j {
+			return j
+		}
+
+		elements[i], elements[j] = elements[j], elements[i]
+		if onSwap != nil {
+			onSwap(i, j)
+		}
+		i++
+		j--
+	}
+}
@@ -1,7 +1,10 @@
 package partitioning
 
 import (
+	"cmp"
+	"math/rand"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -35,3 +38,55 @@ func TestHoarePartition(t *testing.T) {
 		}
 	}
 }
+
+func TestHoarePartitionFunc(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+		index    int
+	}{
+		{[]int{4, 3, 2, 1}, []int{1, 3, 2, 4}, 2},       // reverse order
+		{[]int{1, 2, 3, 4}, []int{1, 2, 3, 4}, 0},       // already sorted
+		{[]int{5, 1, 4, 2, 3}, []int{3, 1, 4, 2, 5}, 3}, // random order
+		{[]int{10}, []int{10}, 0},                       // single element
+		{[]int{}, []int{}, -1},                          // empty slice
+		{[]int{2, 2, 2, 2}, []int{2, 2, 2, 2}, 1},       // all elements equal
+		{[]int{2, 1}, []int{1, 2}, 0},                   // two elements unsorted
+		{[]int{-3, -1, -2, -4}, []int{-4, -1, -2, -3}, 0},
+	}
+
+	for _, tt := range tests {
+		inputCopy := make([]int, len(tt.input))
+		copy(inputCopy, tt.input)
+		index := HoarePartitionFunc(inputCopy, cmp.Compare[int], nil)
+		if !reflect.DeepEqual(inputCopy, tt.expected) || index != tt.index {
+			t.Errorf("HoarePartitionFunc(%v) = %v, %d; want %v, %d", tt.input, inputCopy, index, tt.expected, tt.index)
+		}
+	}
+}
+
+func TestHoarePartitionFuncSplitsAroundPivot(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	swaps := 0
+	for n := 2; n < 200; n++ {
+		elements := make([]string, n)
+		for i := range elements {
+			elements[i] = string(rune('a' + random.Intn(26)))
+		}
+		pivot := elements[0]
+
+		p := HoarePartitionFunc(elements, strings.Compare, func(i, j int) { swaps++ })
+
+		if p < 0 || p > n-2 {
+			t.Fatalf("Expected both partitions of %d elements to be non-empty, but split at %d", n, p)
+		}
+		for i, element := range elements {
+			if (i <= p && element > pivot) || (i > p && element < pivot) {
+				t.Fatalf("Element %q at %d is on the wrong side of pivot %q split at %d: %v", element, i, pivot, p, elements)
+			}
+		}
+	}
+	if swaps == 0 {
+		t.Errorf("Expected onSwap to be called")
+	}
+}
@@ -1,6 +1,7 @@
 package sorting
 
 import (
+	"github.com/lebruchette/algos/types"
 	"math/rand"
 	golangSort "sort"
 	"testing"
@@ -19,6 +20,77 @@ var iteration = []testCase{
 	{"400000", 400000},
 }
 
+// sortPatterns generate the kinds of input that quicksorts are commonly sensitive to.
+var sortPatterns = []struct {
+	name     string
+	generate func(random *rand.Rand, n int) []int
+}{
+	{"random", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return random.Int() })
+	}},
+	{"sorted", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return i })
+	}},
+	{"reversed", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return n - i })
+	}},
+	{"nearly sorted", func(random *rand.Rand, n int) []int {
+		data := generateInts(n, func(i int) int { return i })
+		for i := 0; i < n/100; i++ {
+			a, b := random.Intn(n), random.Intn(n)
+			data[a], data[b] = data[b], data[a]
+		}
+		return data
+	}},
+	{"sawtooth", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return i % 100 })
+	}},
+	{"organ pipe", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return min(i, n-i) })
+	}},
+	{"few unique", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return random.Intn(4) })
+	}},
+	{"all equal", func(random *rand.Rand, n int) []int {
+		return generateInts(n, func(i int) int { return 7 })
+	}},
+}
+
+func generateInts(n int, value func(i int) int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = value(i)
+	}
+	return data
+}
+
+// BenchmarkSortPatterns compares the general-purpose sorts on each of the sortPatterns.
+func BenchmarkSortPatterns(b *testing.B) {
+	sorts := []struct {
+		name     string
+		sortFunc func([]int)
+	}{
+		{"GolangSort", golangSort.Ints},
+		{"QuickSort", QuickSort[int]},
+		{"Sort", Sort[int]},
+		{"PdqSort", PdqSort[int]},
+		{"GolangSortStable", func(data []int) { golangSort.Stable(golangSort.IntSlice(data)) }},
+		{"TimSort", TimSort[int]},
+	}
+	for _, pattern := range sortPatterns {
+		input := pattern.generate(rand.New(rand.NewSource(1)), 100000)
+		data := make([]int, len(input))
+		for _, s := range sorts {
+			b.Run(pattern.name+"/"+s.name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(data, input)
+					s.sortFunc(data)
+				}
+			})
+		}
+	}
+}
+
 func BenchmarkGolangSort(b *testing.B) {
 	runSortBenchmark(b, golangSort.Ints)
 }
@@ -39,6 +111,53 @@ func BenchmarkHeapSort(b *testing.B) {
 	runSortBenchmark(b, HeapSort)
 }
 
+func BenchmarkSort(b *testing.B) {
+	runSortBenchmark(b, Sort)
+}
+
+func BenchmarkPdqSort(b *testing.B) {
+	runSortBenchmark(b, PdqSort)
+}
+
+func BenchmarkTimSort(b *testing.B) {
+	runSortBenchmark(b, TimSort)
+}
+
+// BenchmarkSortWork reports the work done by each algorithm on the same random input, as custom benchmark metrics.
+func BenchmarkSortWork(b *testing.B) {
+	sorts := []struct {
+		name     string
+		sortFunc func([]int, types.Comparator[int]) Stats
+	}{
+		{"InsertionSort", InsertionSortWithStats[int]},
+		{"MergeSort", MergeSortWithStats[int]},
+		{"QuickSort", QuickSortWithStats[int]},
+		{"HeapSort", HeapSortWithStats[int]},
+	}
+	for _, s := range sorts {
+		for _, tc := range iteration[:3] {
+			b.Run(s.name+"/"+tc.name, func(b *testing.B) {
+				random := rand.New(rand.NewSource(1))
+				input := make([]int, tc.n)
+				for i := range input {
+					input[i] = random.Intn(tc.n)
+				}
+				data := make([]int, tc.n)
+
+				var stats Stats
+				for i := 0; i < b.N; i++ {
+					copy(data, input)
+					stats = s.sortFunc(data, types.DefaultComparator[int]{})
+				}
+				b.ReportMetric(float64(stats.Comparisons), "comparisons/op")
+				b.ReportMetric(float64(stats.Swaps), "swaps/op")
+				b.ReportMetric(float64(stats.Moves), "moves/op")
+				b.ReportMetric(float64(stats.Allocations), "buffers/op")
+			})
+		}
+	}
+}
+
 func runSortBenchmark(b *testing.B, sortFunc func([]int)) {
 	for _, tc := range iteration {
 		b.Run(tc.name, func(b *testing.B) {
@@ -0,0 +1,104 @@
+package sorting
+
+import (
+	"cmp"
+	"github.com/lebruchette/algos/types"
+	"testing"
+)
+
+func TestHeapSortComparisonsAreLinearithmic(t *testing.T) {
+	data := make([]int, 10000)
+	for i := range data {
+		data[i] = (i * 7919) % len(data)
+	}
+	counter := types.NewCountingComparator[int](types.DefaultComparator[int]{})
+
+	HeapSortWithComparator[int](data, counter)
+
+	for i := range data {
+		if data[i] != i {
+			t.Fatalf("Expected %d at index %d, but got %d", i, i, data[i])
+		}
+	}
+	// at most a few comparisons per level of the heap for each element, i.e. O(n log n)
+	if limit := 4 * len(data) * 14; counter.Total() > limit {
+		t.Errorf("Expected at most %d comparisons, but got %d", limit, counter.Total())
+	}
+}
+
+// quickSortAdversary is McIlroy's "killer adversary" for quicksort: it decides the values of the elements being
+// sorted lazily, as they are compared, so as to make every pivot as bad as possible.
+type quickSortAdversary struct {
+	values    []int
+	gas       int
+	solid     int
+	candidate int
+}
+
+func newQuickSortAdversary(n int) (*quickSortAdversary, []int) {
+	adversary := &quickSortAdversary{values: make([]int, n), gas: n, candidate: -1}
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+		adversary.values[i] = adversary.gas
+	}
+	return adversary, items
+}
+
+func (a *quickSortAdversary) compare(x, y int) int {
+	if a.values[x] == a.gas && a.values[y] == a.gas {
+		if x == a.candidate {
+			a.freeze(x)
+		} else {
+			a.freeze(y)
+		}
+	}
+	if a.values[x] == a.gas {
+		a.candidate = x
+	} else if a.values[y] == a.gas {
+		a.candidate = y
+	}
+	return cmp.Compare(a.values[x], a.values[y])
+}
+
+func (a *quickSortAdversary) freeze(x int) {
+	a.values[x] = a.solid
+	a.solid++
+}
+
+func TestSortIsLinearithmicAgainstAdversary(t *testing.T) {
+	const n = 10000
+	limit := 10 * n * 14
+
+	adversary, items := newQuickSortAdversary(n)
+	counter := types.NewCountingComparator(types.FromCompareFunc(adversary.compare))
+	SortWithComparator[int](items, counter)
+	if counter.Total() > limit {
+		t.Errorf("Expected at most %d comparisons, but got %d", limit, counter.Total())
+	}
+	for i := 1; i < n; i++ {
+		if adversary.values[items[i-1]] > adversary.values[items[i]] {
+			t.Fatalf("Expected the items to be sorted by their final values, but %d > %d at index %d",
+				adversary.values[items[i-1]], adversary.values[items[i]], i)
+		}
+	}
+}
+
+func TestPdqSortIsLinearithmicAgainstAdversary(t *testing.T) {
+	const n = 10000
+	limit := 10 * n * 14
+
+	adversary, items := newQuickSortAdversary(n)
+	counter := types.NewCountingComparator(types.FromCompareFunc(adversary.compare))
+	PdqSortWithComparator[int](items, counter)
+
+	if counter.Total() > limit {
+		t.Errorf("Expected at most %d comparisons, but got %d", limit, counter.Total())
+	}
+	for i := 1; i < n; i++ {
+		if adversary.values[items[i-1]] > adversary.values[items[i]] {
+			t.Fatalf("Expected the items to be sorted by their final values, but %d > %d at index %d",
+				adversary.values[items[i-1]], adversary.values[items[i]], i)
+		}
+	}
+}
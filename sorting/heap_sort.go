@@ -5,13 +5,47 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// HeapSort sorts a slice of ordered types in ascending order using the heap sort algorithm.
+// It uses types.OrderedComparator, so floats are totally ordered with NaNs first and -0 before +0.
 func HeapSort[T constraints.Ordered](data []T) {
-	// create a max heap that satisfies properties of a max heap
-	heap := types.NewHeap(types.MaxHeap, data)
+	HeapSortWithComparator(data, types.OrderedComparator[T]())
+}
+
+// HeapSortWithComparator sorts a slice of any type in ascending order using the heap sort algorithm and a custom
+// comparator.  It runs in O(n log n) time for any input, sorts in place and does not allocate.
+func HeapSortWithComparator[T any](data []T, comparator types.Comparator[T]) {
+	heapSortFunc(data, types.CompareFunc(comparator), nil)
+}
+
+// heapSortFunc sorts items with heap sort using the provided three-way comparison.  It arranges items into a max heap
+// in place, then repeatedly swaps the root, the greatest remaining element, behind the shrinking heap.  Swaps are
+// recorded in stats unless it is nil.
+func heapSortFunc[T any](items []T, compare func(a, b T) int, stats *Stats) {
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		siftDown(items, compare, i, len(items), stats)
+	}
+	for end := len(items) - 1; end > 0; end-- {
+		items[0], items[end] = items[end], items[0]
+		stats.addSwaps(1)
+		siftDown(items, compare, 0, end, stats)
+	}
+}
 
-	// swap the root node to the end and heapify
-	for i := len(data) - 1; i > -1; i-- {
-		heap.Data[0], heap.Data[i] = heap.Data[i], heap.Data[0]
-		heap.HeapifyToIndex(i)
+// siftDown restores the max heap property of items[:length] by moving the element at index root down.
+func siftDown[T any](items []T, compare func(a, b T) int, root, length int, stats *Stats) {
+	for {
+		child := 2*root + 1
+		if child >= length {
+			return
+		}
+		if child+1 < length && compare(items[child], items[child+1]) < 0 {
+			child++
+		}
+		if compare(items[root], items[child]) >= 0 {
+			return
+		}
+		items[root], items[child] = items[child], items[root]
+		stats.addSwaps(1)
+		root = child
 	}
 }
@@ -1,8 +1,11 @@
 package sorting
 
 import (
+	"github.com/lebruchette/algos/types"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestHeapSortInts(t *testing.T) {
@@ -69,3 +72,44 @@ func TestHeapSortNegativeInts(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, data)
 	}
 }
+
+func TestHeapSortWithNaNAndSignedZeros(t *testing.T) {
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+	data := []float64{3, nan, 0, 1, nan, negativeZero, -2}
+	expected := floatBits([]float64{nan, nan, -2, negativeZero, 0, 1, 3})
+
+	HeapSort(data)
+
+	if got := floatBits(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestHeapSortWithComparator(t *testing.T) {
+	bob := types.Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	frank := types.Person{Name: "Frank", Dob: time.Date(1992, time.September, 25, 0, 0, 0, 0, time.UTC)}
+	alice := types.Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	diana := types.Person{Name: "Diana", Dob: time.Date(1995, time.December, 5, 0, 0, 0, 0, time.UTC)}
+	charlie := types.Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := types.Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	data := []types.Person{bob, frank, alice, diana, charlie, eve}
+	expected := []types.Person{bob, eve, alice, frank, diana, charlie}
+
+	HeapSortWithComparator[types.Person](data, types.PersonComparator{})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, got %v", expected, data)
+	}
+}
+
+func TestHeapSortDoesNotAllocate(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = (i * 7919) % len(data)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { HeapSort(data) }); allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+}
@@ -6,9 +6,9 @@ import (
 )
 
 // InsertionSort sorts a slice of ordered types in ascending order using the insertion sort algorithm.
-// It uses the default comparator for types that satisfy constraints.Ordered.
+// It uses types.OrderedComparator, so floats are totally ordered with NaNs first and -0 before +0.
 func InsertionSort[T constraints.Ordered](data []T) {
-	sort[T](data, types.DefaultComparator[T]{})
+	sort[T](data, types.OrderedComparator[T](), nil)
 }
 
 // InsertionSortWithComparator sorts a slice of any type using the insertion sort algorithm.
@@ -16,13 +16,14 @@ func InsertionSort[T constraints.Ordered](data []T) {
 // The comparator should return true if the first argument is "greater than" the second argument
 // (or whatever custom logic is required for sorting).
 func InsertionSortWithComparator[T any](data []T, comparator types.Comparator[T]) {
-	sort[T](data, comparator)
+	sort[T](data, comparator, nil)
 }
 
 // sort is the core implementation of the insertion sort algorithm.
 // It iterates through the slice, and for each element, it places it in its correct position
 // relative to the already sorted portion of the slice, using the provided comparator.
-func sort[T any](data []T, comparator types.Comparator[T]) {
+// Work done is recorded in stats unless it is nil.
+func sort[T any](data []T, comparator types.Comparator[T], stats *Stats) {
 	n := len(data)
 	for i := 1; i < n; i++ {
 		key := data[i]
@@ -31,9 +32,11 @@ func sort[T any](data []T, comparator types.Comparator[T]) {
 		// (as determined by the comparator) one position ahead.
 		for j >= 0 && comparator.GreaterThan(data[j], key) {
 			data[j+1] = data[j]
+			stats.addMoves(1)
 			j--
 		}
 		// Place the key in its correct position.
 		data[j+1] = key
+		stats.addMoves(1)
 	}
 }
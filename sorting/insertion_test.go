@@ -2,6 +2,7 @@ package sorting
 
 import (
 	"github.com/lebruchette/algos/types"
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -101,3 +102,57 @@ func TestInsertionSortWithComparator(t *testing.T) {
 		t.Errorf("Expected %v, but got %v", expected, data)
 	}
 }
+
+func TestInsertionSortWithCombinedComparator(t *testing.T) {
+	bob := types.Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	erin := types.Person{Name: "Erin", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := types.Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	carol := types.Person{Name: "Carol", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	dave := types.Person{Name: "Dave", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	data := []types.Person{erin, carol, bob, dave, alice}
+	expected := []types.Person{dave, alice, carol, bob, erin}
+
+	// youngest first, then by name
+	InsertionSortWithComparator(data, types.ThenBy(
+		types.Reverse[types.Person](types.PersonComparator{}),
+		types.ByKey(func(p types.Person) string { return p.Name }),
+	))
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestInsertionSortWithStringComparator(t *testing.T) {
+	data := []string{"file10.txt", "File2.txt", "résumé.pdf", "file1.txt", "Zebra.png", "apple.png", "Resume.doc"}
+	expected := []string{"apple.png", "file1.txt", "File2.txt", "file10.txt", "Resume.doc", "résumé.pdf", "Zebra.png"}
+
+	InsertionSortWithComparator[string](data, types.StringComparator{Natural: true, IgnoreCase: true, IgnoreAccents: true})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestInsertionSortWithNaNAndSignedZeros(t *testing.T) {
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+	data := []float64{3, nan, 0, 1, nan, negativeZero, -2}
+	expected := floatBits([]float64{nan, nan, -2, negativeZero, 0, 1, 3})
+
+	InsertionSort(data)
+
+	if got := floatBits(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+// floatBits returns the bit patterns of data, which unlike the floats themselves can be compared with
+// reflect.DeepEqual when they include NaNs and signed zeros.
+func floatBits(data []float64) []uint64 {
+	bits := make([]uint64, len(data))
+	for i, f := range data {
+		bits[i] = math.Float64bits(f)
+	}
+	return bits
+}
@@ -0,0 +1,260 @@
+package sorting
+
+import (
+	"math/bits"
+
+	"github.com/lebruchette/algos/types"
+	"golang.org/x/exp/constraints"
+)
+
+// PdqSort sorts a slice of ordered types in ascending order using pattern-defeating quicksort, which detects sorted,
+// reverse sorted and duplicate-heavy input and sorts it in linear or near-linear time, and falls back to an in-place
+// heap sort after too many unbalanced partitions for an O(n log n) worst case.  It uses types.OrderedComparator, so
+// floats are totally ordered with NaNs first and -0 before +0.  The sort is not stable and does not allocate.
+func PdqSort[T constraints.Ordered](items []T) {
+	PdqSortWithComparator(items, types.OrderedComparator[T]())
+}
+
+// PdqSortWithComparator sorts a slice of any type using pattern-defeating quicksort and a custom comparator, like
+// PdqSort.
+func PdqSortWithComparator[T any](items []T, comparator types.Comparator[T]) {
+	pdqSort(items, types.CompareFunc(comparator), 0, len(items), bits.Len(uint(len(items))))
+}
+
+// sortedHint is what choosePivot learned about the order of the elements it sampled.
+type sortedHint int
+
+const (
+	unknownHint sortedHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// The pdq functions implement pattern-defeating quicksort after Orson Peters' pdqsort, as adapted by the Go standard
+// library.  They work on the half-open range [a, b) of items, since some steps look at the element just before it.
+
+// pdqSort sorts items[a:b].  limit is the number of bad pivots tolerated before falling back to heap sort.
+func pdqSort[T any](items []T, compare func(a, b T) int, a, b, limit int) {
+	wasBalanced, wasPartitioned := true, true
+	for {
+		length := b - a
+		if length <= quickSortCutoff {
+			insertionSortFunc(items[a:b], compare, nil)
+			return
+		}
+		if limit == 0 {
+			heapSortFunc(items[a:b], compare, nil)
+			return
+		}
+		// the previous partition was very unbalanced, so shuffle some elements to break up the pattern that caused it
+		if !wasBalanced {
+			breakPatterns(items, a, b)
+			limit--
+		}
+
+		pivot, hint := choosePivot(items, compare, a, b)
+		if hint == decreasingHint {
+			reverseRange(items, a, b)
+			// reversing the range moved the pivot to the mirrored index
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+		// the samples were in order and the last partitioning pass moved nothing: the range is likely sorted already
+		if wasBalanced && wasPartitioned && hint == increasingHint && partialInsertionSort(items, compare, a, b) {
+			return
+		}
+		// the pivot equals the element just before the range, which is a previous pivot and no greater than any
+		// element of the range, so elements equal to it can be split off and need no further sorting
+		if a > 0 && compare(items[a-1], items[pivot]) >= 0 {
+			a = pdqPartitionEqual(items, compare, a, b, pivot)
+			continue
+		}
+
+		mid, alreadyPartitioned := pdqPartition(items, compare, a, b, pivot)
+		wasPartitioned = alreadyPartitioned
+
+		// recurse into the smaller side and loop on the larger one
+		leftLength, rightLength := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLength < rightLength {
+			wasBalanced = leftLength >= balanceThreshold
+			pdqSort(items, compare, a, mid, limit)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLength >= balanceThreshold
+			pdqSort(items, compare, mid+1, b, limit)
+			b = mid
+		}
+	}
+}
+
+// partition moves the pivot to its final index mid, with smaller elements before it and the rest after it.
+// alreadyPartitioned reports whether no elements had to be exchanged.
+func pdqPartition[T any](items []T, compare func(a, b T) int, a, b, pivot int) (mid int, alreadyPartitioned bool) {
+	items[a], items[pivot] = items[pivot], items[a]
+	i, j := a+1, b-1
+	for i <= j && compare(items[i], items[a]) < 0 {
+		i++
+	}
+	for i <= j && compare(items[j], items[a]) >= 0 {
+		j--
+	}
+	if i > j {
+		items[j], items[a] = items[a], items[j]
+		return j, true
+	}
+	items[i], items[j] = items[j], items[i]
+	i++
+	j--
+
+	for {
+		for i <= j && compare(items[i], items[a]) < 0 {
+			i++
+		}
+		for i <= j && compare(items[j], items[a]) >= 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		items[i], items[j] = items[j], items[i]
+		i++
+		j--
+	}
+	items[j], items[a] = items[a], items[j]
+	return j, false
+}
+
+// partitionEqual moves the elements equal to the pivot, which no element of the range is less than, to the front of
+// the range and returns the index of the first greater element.
+func pdqPartitionEqual[T any](items []T, compare func(a, b T) int, a, b, pivot int) int {
+	items[a], items[pivot] = items[pivot], items[a]
+	i, j := a+1, b-1
+	for {
+		for i <= j && compare(items[a], items[i]) >= 0 {
+			i++
+		}
+		for i <= j && compare(items[a], items[j]) < 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		items[i], items[j] = items[j], items[i]
+		i++
+		j--
+	}
+	return i
+}
+
+// partialInsertionSort sorts a nearly sorted range by fixing up to a handful of out of order elements, and reports
+// whether that was enough to sort it.
+func partialInsertionSort[T any](items []T, compare func(a, b T) int, a, b int) bool {
+	const (
+		maxSteps = 5
+		// ranges shorter than this are not worth fixing up, the partitioning pass is cheap enough
+		shortestShifting = 50
+	)
+	i := a + 1
+	for step := 0; step < maxSteps; step++ {
+		for i < b && compare(items[i], items[i-1]) >= 0 {
+			i++
+		}
+		if i == b {
+			return true
+		}
+		if b-a < shortestShifting {
+			return false
+		}
+		items[i], items[i-1] = items[i-1], items[i]
+
+		// shift the smaller element to the left and the greater one to the right
+		for j := i - 1; j > a && compare(items[j], items[j-1]) < 0; j-- {
+			items[j], items[j-1] = items[j-1], items[j]
+		}
+		for j := i + 1; j < b && compare(items[j], items[j-1]) < 0; j++ {
+			items[j], items[j-1] = items[j-1], items[j]
+		}
+	}
+	return false
+}
+
+// breakPatterns swaps three elements around the middle of the range with pseudo-random others.
+func breakPatterns[T any](items []T, a, b int) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+	random := xorshift(length)
+	modulus := uint(1) << bits.Len(uint(length))
+	idx := a + (length/4)*2 - 1
+	for i := 0; i < 3; i++ {
+		other := int(uint(random.next()) & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		items[idx-1+i], items[a+other] = items[a+other], items[idx-1+i]
+	}
+}
+
+// choosePivot returns the index of the median of three elements, or for long ranges of three medians of three
+// (Tukey's ninther), along with what the samples suggest about the order of the range.
+func choosePivot[T any](items []T, compare func(a, b T) int, a, b int) (int, sortedHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+	length := b - a
+	swaps := 0
+	i, j, k := a+length/4*1, a+length/4*2, a+length/4*3
+	if length >= 8 {
+		if length >= shortestNinther {
+			i = medianOfThree(items, compare, i-1, i, i+1, &swaps)
+			j = medianOfThree(items, compare, j-1, j, j+1, &swaps)
+			k = medianOfThree(items, compare, k-1, k, k+1, &swaps)
+		}
+		j = medianOfThree(items, compare, i, j, k, &swaps)
+	}
+
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+// medianOfThree returns the index of the median of the elements at indices i, j and k, counting in swaps how many pairs
+// were out of order.
+func medianOfThree[T any](items []T, compare func(a, b T) int, i, j, k int, swaps *int) int {
+	i, j = order2(items, compare, i, j, swaps)
+	j, k = order2(items, compare, j, k, swaps)
+	_, j = order2(items, compare, i, j, swaps)
+	return j
+}
+
+func order2[T any](items []T, compare func(a, b T) int, i, j int, swaps *int) (int, int) {
+	if compare(items[j], items[i]) < 0 {
+		*swaps++
+		return j, i
+	}
+	return i, j
+}
+
+func reverseRange[T any](items []T, a, b int) {
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+// xorshift is a fast, deterministic pseudo-random number generator, good enough for breaking up patterns.
+type xorshift uint64
+
+func (r *xorshift) next() uint64 {
+	*r ^= *r << 13
+	*r ^= *r >> 7
+	*r ^= *r << 17
+	return uint64(*r)
+}
@@ -0,0 +1,131 @@
+package sorting
+
+import (
+	"github.com/lebruchette/algos/types"
+	"math"
+	"math/rand"
+	"reflect"
+	golangSort "sort"
+	"testing"
+	"time"
+)
+
+func TestPdqSortWithInts(t *testing.T) {
+	data := []int{5, 2, 9, 1, 5, 6}
+	expected := []int{1, 2, 5, 5, 6, 9}
+
+	PdqSort(data)
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestPdqSortWithComparator(t *testing.T) {
+	bob := types.Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	frank := types.Person{Name: "Frank", Dob: time.Date(1992, time.September, 25, 0, 0, 0, 0, time.UTC)}
+	alice := types.Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	diana := types.Person{Name: "Diana", Dob: time.Date(1995, time.December, 5, 0, 0, 0, 0, time.UTC)}
+	charlie := types.Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := types.Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	data := []types.Person{bob, frank, alice, diana, charlie, eve}
+	expected := []types.Person{bob, eve, alice, frank, diana, charlie}
+
+	PdqSortWithComparator[types.Person](data, types.PersonComparator{})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestPdqSortWithNaNAndSignedZeros(t *testing.T) {
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+	data := []float64{3, nan, 0, 1, nan, negativeZero, -2}
+	expected := floatBits([]float64{nan, nan, -2, negativeZero, 0, 1, 3})
+
+	PdqSort(data)
+
+	if got := floatBits(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestPdqSortMatchesStandardLibrary(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, pattern := range sortPatterns {
+		for _, n := range []int{0, 1, 2, 12, 13, 49, 50, 51, 100, 1000, 10000} {
+			data := pattern.generate(random, n)
+			expected := make([]int, n)
+			copy(expected, data)
+			golangSort.Ints(expected)
+
+			PdqSort(data)
+
+			if !reflect.DeepEqual(data, expected) {
+				t.Errorf("%s/%d: expected %v, but got %v", pattern.name, n, expected, data)
+			}
+		}
+	}
+}
+
+func TestPdqSortIsLinearOnSortedInput(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, pattern := range sortPatterns {
+		if pattern.name != "sorted" && pattern.name != "reversed" && pattern.name != "all equal" {
+			continue
+		}
+		data := pattern.generate(random, 10000)
+		counter := types.NewCountingComparator[int](types.DefaultComparator[int]{})
+
+		PdqSortWithComparator[int](data, counter)
+
+		// a couple of passes over the data, rather than the ~14 levels of partitioning of an unsorted input
+		if limit := 4 * len(data); counter.Total() > limit {
+			t.Errorf("%s: expected at most %d comparisons, but got %d", pattern.name, limit, counter.Total())
+		}
+	}
+}
+
+func TestPdqSortDoesNotAllocate(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	ints := make([]int, 1000)
+	people := make([]types.Person, 1000)
+	for i := range ints {
+		ints[i] = random.Int()
+		people[i] = types.Person{Dob: time.Unix(random.Int63n(1<<31), 0)}
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { PdqSort(ints) }); allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+	comparator := types.PersonComparator{}
+	if allocs := testing.AllocsPerRun(10, func() { PdqSortWithComparator[types.Person](people, comparator) }); allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+}
+
+func TestPdqSortHeapSortFallbackDoesNotAllocate(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = random.Intn(100)
+	}
+	expected := make([]int, len(input))
+	copy(expected, input)
+	golangSort.Ints(expected)
+	data := make([]int, len(input))
+	compare := types.CompareFunc(types.OrderedComparator[int]())
+
+	// a limit of zero sends the whole range straight to the heap sort fallback
+	allocs := testing.AllocsPerRun(10, func() {
+		copy(data, input)
+		pdqSort(data, compare, 0, len(data), 0)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
@@ -1,40 +1,83 @@
 package sorting
 
 import (
+	"github.com/lebruchette/algos/partitioning"
 	"github.com/lebruchette/algos/types"
 	"golang.org/x/exp/constraints"
 )
 
-// QuickSort sorts the given slice of ordered items in-place using the default comparator.
+// quickSortCutoff is the length at or below which quickSort hands a partition to insertion sort, which is faster
+// than further partitioning for so few elements.
+const quickSortCutoff = 12
+
+// QuickSort sorts the given slice of ordered items in-place using types.OrderedComparator, so floats are totally
+// ordered with NaNs first and -0 before +0.  The sort is not stable and does not allocate.
 func QuickSort[T constraints.Ordered](items []T) {
-	copy(items, quickSort(items, types.DefaultComparator[T]{}))
+	quickSort(items, types.CompareFunc(types.OrderedComparator[T]()), nil)
 }
 
-// QuickSortWithComparator sorts the given slice of items in-place using a custom comparator.
+// QuickSortWithComparator sorts the given slice of items in-place using a custom comparator.  The sort is not stable
+// and does not allocate.
 func QuickSortWithComparator[T any](items []T, comparator types.Comparator[T]) {
-	copy(items, quickSort(items, comparator))
+	quickSort(items, types.CompareFunc(comparator), nil)
 }
 
-// quickSort recursively sorts the slice using the provided comparator and returns a new sorted slice.
-func quickSort[T any](items []T, comparator types.Comparator[T]) []T {
-	if len(items) <= 1 {
-		return items
+// quickSort sorts the slice in-place using the provided three-way comparison, so each element is compared with the
+// pivot exactly once per partitioning pass.  Partitions are split with partitioning.HoarePartitionFunc around the
+// median of their first, middle and last elements; only the smaller side is sorted recursively, which bounds the
+// recursion depth to O(log n).  Work done is recorded in stats unless it is nil.
+func quickSort[T any](items []T, compare func(a, b T) int, stats *Stats) {
+	var onSwap func(i, j int)
+	if stats != nil {
+		onSwap = func(i, j int) { stats.addSwaps(1) }
 	}
 
-	var left, middle, right []T
-	pivot := len(items) / 2
-
-	for i := range items {
-		switch {
-		case comparator.LessThan(items[i], items[pivot]):
-			left = append(left, items[i])
-		case comparator.EqualTo(items[i], items[pivot]):
-			middle = append(middle, items[i])
-		case comparator.GreaterThan(items[i], items[pivot]):
-			right = append(right, items[i])
+	for len(items) > quickSortCutoff {
+		medianToFront(items, compare, stats)
+		p := partitioning.HoarePartitionFunc(items, compare, onSwap)
+		if left, right := items[:p+1], items[p+1:]; len(left) < len(right) {
+			quickSort(left, compare, stats)
+			items = right
+		} else {
+			quickSort(right, compare, stats)
+			items = left
 		}
 	}
+	insertionSortFunc(items, compare, stats)
+}
 
-	return append(append(quickSort[T](left, comparator), middle...), quickSort[T](right, comparator)...)
+// medianToFront moves the median of the first, middle and last elements to the front of items, where
+// partitioning.HoarePartitionFunc takes its pivot from.  This avoids quadratic behaviour on sorted and reverse sorted
+// input.
+func medianToFront[T any](items []T, compare func(a, b T) int, stats *Stats) {
+	first, mid, last := 0, len(items)/2, len(items)-1
+	if compare(items[mid], items[first]) < 0 {
+		mid, first = first, mid
+	}
+	if compare(items[last], items[mid]) < 0 {
+		mid = last
+		if compare(items[mid], items[first]) < 0 {
+			mid = first
+		}
+	}
+	if mid != 0 {
+		items[0], items[mid] = items[mid], items[0]
+		stats.addSwaps(1)
+	}
+}
 
+// insertionSortFunc is the insertion sort of insertion.go for a three-way comparison, used by quickSort for small
+// partitions.
+func insertionSortFunc[T any](items []T, compare func(a, b T) int, stats *Stats) {
+	for i := 1; i < len(items); i++ {
+		key := items[i]
+		j := i - 1
+		for j >= 0 && compare(items[j], key) > 0 {
+			items[j+1] = items[j]
+			stats.addMoves(1)
+			j--
+		}
+		items[j+1] = key
+		stats.addMoves(1)
+	}
 }
@@ -2,7 +2,10 @@ package sorting
 
 import (
 	"github.com/lebruchette/algos/types"
+	"math"
+	"math/rand"
 	"reflect"
+	golangSort "sort"
 	"testing"
 	"time"
 )
@@ -120,3 +123,131 @@ func TestQuickSortWithReverseOrderedCustomObjects(t *testing.T) {
 		t.Errorf("Expected %v, but got %v", expected, data)
 	}
 }
+
+func TestQuickSortWithCombinedComparator(t *testing.T) {
+	bob := types.Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	erin := types.Person{Name: "Erin", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := types.Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	carol := types.Person{Name: "Carol", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	dave := types.Person{Name: "Dave", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	data := []types.Person{erin, carol, bob, dave, alice}
+	expected := []types.Person{dave, alice, carol, bob, erin}
+
+	// youngest first, then by name
+	QuickSortWithComparator(data, types.ThenBy(
+		types.Reverse[types.Person](types.PersonComparator{}),
+		types.ByKey(func(p types.Person) string { return p.Name }),
+	))
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+// threeWayOnly is a comparator whose boolean methods must not be called, to check that quickSort only uses Compare.
+type threeWayOnly struct {
+	t *testing.T
+}
+
+func (c threeWayOnly) GreaterThan(a, b int) bool { return c.unexpected("GreaterThan") }
+func (c threeWayOnly) LessThan(a, b int) bool    { return c.unexpected("LessThan") }
+func (c threeWayOnly) EqualTo(a, b int) bool     { return c.unexpected("EqualTo") }
+func (c threeWayOnly) Compare(a, b int) int      { return a - b }
+
+func (c threeWayOnly) unexpected(method string) bool {
+	c.t.Fatalf("Unexpected call to %s", method)
+	return false
+}
+
+func TestQuickSortWithThreeWayComparator(t *testing.T) {
+	data := []int{5, 2, 9, 1, 5, 6}
+	expected := []int{1, 2, 5, 5, 6, 9}
+
+	QuickSortWithComparator[int](data, threeWayOnly{t})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestQuickSortWithStringComparator(t *testing.T) {
+	data := []string{"file10.txt", "File2.txt", "résumé.pdf", "file1.txt", "Zebra.png", "apple.png", "Resume.doc"}
+	expected := []string{"apple.png", "file1.txt", "File2.txt", "file10.txt", "Resume.doc", "résumé.pdf", "Zebra.png"}
+
+	QuickSortWithComparator[string](data, types.StringComparator{Natural: true, IgnoreCase: true, IgnoreAccents: true})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestQuickSortWithNaNAndSignedZeros(t *testing.T) {
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+	data := []float64{3, nan, 0, 1, nan, negativeZero, -2}
+	expected := floatBits([]float64{nan, nan, -2, negativeZero, 0, 1, 3})
+
+	QuickSort(data)
+
+	if got := floatBits(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestQuickSortMatchesStandardLibrary(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	inputs := []struct {
+		name     string
+		generate func(i, n int) int
+	}{
+		{"random", func(i, n int) int { return random.Intn(n) }},
+		{"random wide", func(i, n int) int { return random.Int() }},
+		{"sorted", func(i, n int) int { return i }},
+		{"reversed", func(i, n int) int { return n - i }},
+		{"few unique", func(i, n int) int { return random.Intn(3) }},
+		{"organ pipe", func(i, n int) int { return min(i, n-i) }},
+		{"sawtooth", func(i, n int) int { return i % 10 }},
+		{"all equal", func(i, n int) int { return 7 }},
+	}
+	for _, input := range inputs {
+		for _, n := range []int{0, 1, 2, 11, 12, 13, 100, 1000} {
+			data := make([]int, n)
+			for i := range data {
+				data[i] = input.generate(i, n)
+			}
+			expected := make([]int, n)
+			copy(expected, data)
+			golangSort.Ints(expected)
+
+			QuickSort(data)
+
+			if !reflect.DeepEqual(data, expected) {
+				t.Errorf("%s/%d: expected %v, but got %v", input.name, n, expected, data)
+			}
+		}
+	}
+}
+
+func TestQuickSortDoesNotAllocate(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	ints := make([]int, 1000)
+	floats := make([]float64, 1000)
+	people := make([]types.Person, 1000)
+	for i := range ints {
+		ints[i] = random.Int()
+		floats[i] = random.NormFloat64()
+		people[i] = types.Person{Dob: time.Unix(random.Int63n(1<<31), 0)}
+	}
+
+	cases := map[string]func(){
+		"ints":       func() { QuickSort(ints) },
+		"floats":     func() { QuickSort(floats) },
+		"comparator": func() { QuickSortWithComparator[types.Person](people, types.PersonComparator{}) },
+	}
+	for name, sortFunc := range cases {
+		// every run but the first sorts already sorted data, which still has to be partitioned all the way down
+		if allocs := testing.AllocsPerRun(10, sortFunc); allocs != 0 {
+			t.Errorf("%s: expected no allocations, but got %v", name, allocs)
+		}
+	}
+}
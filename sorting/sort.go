@@ -0,0 +1,45 @@
+package sorting
+
+import (
+	"math/bits"
+
+	"github.com/lebruchette/algos/partitioning"
+	"github.com/lebruchette/algos/types"
+	"golang.org/x/exp/constraints"
+)
+
+// Sort sorts a slice of ordered types in ascending order.  It is the general-purpose entry point of the package:
+// an introsort with quicksort's average speed and heap sort's O(n log n) worst case.  It uses
+// types.OrderedComparator, so floats are totally ordered with NaNs first and -0 before +0.  The sort is not stable.
+func Sort[T constraints.Ordered](items []T) {
+	SortWithComparator(items, types.OrderedComparator[T]())
+}
+
+// SortWithComparator sorts a slice of any type using a custom comparator, like Sort.
+func SortWithComparator[T any](items []T, comparator types.Comparator[T]) {
+	introSort(items, types.CompareFunc(comparator), 2*bits.Len(uint(len(items))))
+}
+
+// introSort is quickSort with a recursion budget: once depth partitioning passes have been made along a path without
+// reaching a small partition, the pivots are evidently poor and the remaining partition is heap sorted instead.
+// Small partitions are insertion sorted as in quickSort.
+func introSort[T any](items []T, compare func(a, b T) int, depth int) {
+	for len(items) > quickSortCutoff {
+		if depth == 0 {
+			heapSortFunc(items, compare, nil)
+			return
+		}
+		depth--
+
+		medianToFront(items, compare, nil)
+		p := partitioning.HoarePartitionFunc(items, compare, nil)
+		if left, right := items[:p+1], items[p+1:]; len(left) < len(right) {
+			introSort(left, compare, depth)
+			items = right
+		} else {
+			introSort(right, compare, depth)
+			items = left
+		}
+	}
+	insertionSortFunc(items, compare, nil)
+}
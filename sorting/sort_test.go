@@ -0,0 +1,119 @@
+package sorting
+
+import (
+	"github.com/lebruchette/algos/types"
+	"math"
+	"math/rand"
+	"reflect"
+	golangSort "sort"
+	"testing"
+	"time"
+)
+
+func TestSortWithInts(t *testing.T) {
+	data := []int{5, 2, 9, 1, 5, 6}
+	expected := []int{1, 2, 5, 5, 6, 9}
+
+	Sort(data)
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestSortWithEmptyAndSingleElement(t *testing.T) {
+	var empty []int
+	Sort(empty)
+	if empty != nil {
+		t.Errorf("Expected nil, but got %v", empty)
+	}
+
+	single := []string{"only"}
+	Sort(single)
+	if !reflect.DeepEqual(single, []string{"only"}) {
+		t.Errorf("Expected %v, but got %v", []string{"only"}, single)
+	}
+}
+
+func TestSortWithComparator(t *testing.T) {
+	bob := types.Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	frank := types.Person{Name: "Frank", Dob: time.Date(1992, time.September, 25, 0, 0, 0, 0, time.UTC)}
+	alice := types.Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	diana := types.Person{Name: "Diana", Dob: time.Date(1995, time.December, 5, 0, 0, 0, 0, time.UTC)}
+	charlie := types.Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := types.Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	data := []types.Person{bob, frank, alice, diana, charlie, eve}
+	expected := []types.Person{bob, eve, alice, frank, diana, charlie}
+
+	SortWithComparator[types.Person](data, types.PersonComparator{})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestSortWithNaNAndSignedZeros(t *testing.T) {
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+	data := []float64{3, nan, 0, 1, nan, negativeZero, -2}
+	expected := floatBits([]float64{nan, nan, -2, negativeZero, 0, 1, 3})
+
+	Sort(data)
+
+	if got := floatBits(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestSortMatchesStandardLibrary(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 12, 13, 100, 1000, 10000} {
+		for _, unique := range []int{1, 3, n} {
+			data := make([]int, n)
+			for i := range data {
+				data[i] = random.Intn(unique)
+			}
+			expected := make([]int, n)
+			copy(expected, data)
+			golangSort.Ints(expected)
+
+			Sort(data)
+
+			if !reflect.DeepEqual(data, expected) {
+				t.Errorf("%d elements with %d unique values: expected %v, but got %v", n, unique, expected, data)
+			}
+		}
+	}
+}
+
+func TestIntroSortFallsBackToHeapSort(t *testing.T) {
+	input := []int{9, 3, 7, 1, 8, 2, 6, 4, 5, 0, 15, 11, 13, 12, 14, 10}
+	data := make([]int, len(input))
+	compare := types.DefaultComparator[int]{}.Compare
+
+	// no recursion budget at all, so the whole slice is heap sorted, in place
+	allocs := testing.AllocsPerRun(10, func() {
+		copy(data, input)
+		introSort(data, compare, 0)
+	})
+
+	expected := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+}
+
+func TestSortDoesNotAllocateOnTypicalInput(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = random.Int()
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { Sort(data) }); allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+}
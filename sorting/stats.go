@@ -0,0 +1,79 @@
+package sorting
+
+import (
+	"github.com/lebruchette/algos/types"
+)
+
+// Stats reports the work done by a single sort, as returned by the ...WithStats functions.
+type Stats struct {
+	// Comparisons is the number of calls made to the comparator, counting a three-way comparison as one
+	Comparisons int
+	// Swaps is the number of times two elements were exchanged in place
+	Swaps int
+	// Moves is the number of elements written to the slice or to auxiliary buffers, other than by swaps
+	Moves int
+	// Allocations is the number of auxiliary buffers allocated, including each time a buffer grows
+	Allocations int
+}
+
+// InsertionSortWithStats sorts data like InsertionSortWithComparator and reports the work done.
+func InsertionSortWithStats[T any](data []T, comparator types.Comparator[T]) Stats {
+	var stats Stats
+	counter := types.NewCountingComparator(comparator)
+	sort[T](data, counter, &stats)
+	stats.Comparisons = counter.Total()
+	return stats
+}
+
+// MergeSortWithStats sorts items like MergeSortWithComparator and reports the work done.
+func MergeSortWithStats[T any](items []T, comparator types.Comparator[T]) Stats {
+	var stats Stats
+	counter := types.NewCountingComparator(comparator)
+	splitAndSort(items, 0, len(items)-1, counter, &stats)
+	stats.Comparisons = counter.Total()
+	return stats
+}
+
+// QuickSortWithStats sorts items like QuickSortWithComparator and reports the work done.
+func QuickSortWithStats[T any](items []T, comparator types.Comparator[T]) Stats {
+	var stats Stats
+	counter := types.NewCountingComparator(comparator)
+	quickSort(items, types.CompareFunc[T](counter), &stats)
+	stats.Comparisons = counter.Total()
+	return stats
+}
+
+// HeapSortWithStats sorts items like HeapSortWithComparator and reports the work done.
+func HeapSortWithStats[T any](items []T, comparator types.Comparator[T]) Stats {
+	var stats Stats
+	counter := types.NewCountingComparator(comparator)
+	heapSortFunc(items, types.CompareFunc[T](counter), &stats)
+	stats.Comparisons = counter.Total()
+	return stats
+}
+
+// addSwaps and addMoves record work if stats are being collected, i.e. if s is not nil.
+func (s *Stats) addSwaps(n int) {
+	if s != nil {
+		s.Swaps += n
+	}
+}
+
+func (s *Stats) addMoves(n int) {
+	if s != nil {
+		s.Moves += n
+	}
+}
+
+// appendTracked appends item to slice like the built-in append, recording the move and any reallocation in stats.
+func appendTracked[T any](stats *Stats, slice []T, item T) []T {
+	before := cap(slice)
+	slice = append(slice, item)
+	if stats != nil {
+		stats.Moves++
+		if cap(slice) != before {
+			stats.Allocations++
+		}
+	}
+	return slice
+}
@@ -0,0 +1,121 @@
+package sorting
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lebruchette/algos/types"
+)
+
+func TestInsertionSortWithStats(t *testing.T) {
+	data := []int{4, 3, 2, 1}
+
+	stats := InsertionSortWithStats[int](data, types.DefaultComparator[int]{})
+
+	if !reflect.DeepEqual(data, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3, 4}, data)
+	}
+	// every pair is compared and shifted once, and each key is written back to its final position
+	expected := Stats{Comparisons: 6, Moves: 9}
+	if stats != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, stats)
+	}
+}
+
+func TestInsertionSortWithStatsOnSortedData(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+
+	stats := InsertionSortWithStats[int](data, types.DefaultComparator[int]{})
+
+	expected := Stats{Comparisons: 3, Moves: 3}
+	if stats != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, stats)
+	}
+}
+
+func TestMergeSortWithStats(t *testing.T) {
+	data := []int{4, 3, 2, 1}
+
+	stats := MergeSortWithStats[int](data, types.DefaultComparator[int]{})
+
+	if !reflect.DeepEqual(data, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3, 4}, data)
+	}
+	// both pairs are swapped, then merged through a buffer and copied back; how often the buffer has to grow is up to
+	// the runtime
+	if stats.Allocations == 0 {
+		t.Errorf("Expected the merge buffer to be allocated, but got %+v", stats)
+	}
+	stats.Allocations = 0
+	expected := Stats{Comparisons: 4, Swaps: 2, Moves: 8}
+	if stats != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, stats)
+	}
+}
+
+func TestQuickSortWithStats(t *testing.T) {
+	data := []int{3, 1, 2}
+
+	stats := QuickSortWithStats[int](data, types.DefaultComparator[int]{})
+
+	if !reflect.DeepEqual(data, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3}, data)
+	}
+	// small slices are insertion sorted: 3 and 1 are each shifted once and both keys written back
+	expected := Stats{Comparisons: 3, Moves: 4}
+	if stats != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, stats)
+	}
+}
+
+func TestQuickSortWithStatsOnLargeData(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = len(data) - i
+	}
+	counter := types.NewCountingComparator[int](types.DefaultComparator[int]{})
+
+	stats := QuickSortWithStats[int](data, counter)
+
+	for i := range data {
+		if data[i] != i+1 {
+			t.Fatalf("Expected %d at index %d, but got %d", i+1, i, data[i])
+		}
+	}
+	if stats.Comparisons != counter.CompareCalls || counter.Total() != counter.CompareCalls {
+		t.Errorf("Expected only three-way comparisons, but got %+v and %+v", stats, *counter)
+	}
+	if stats.Swaps == 0 || stats.Allocations != 0 {
+		t.Errorf("Expected swaps but no allocations, but got %+v", stats)
+	}
+}
+
+func TestHeapSortWithStats(t *testing.T) {
+	data := []int{3, 1, 2}
+
+	stats := HeapSortWithStats[int](data, types.DefaultComparator[int]{})
+
+	if !reflect.DeepEqual(data, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3}, data)
+	}
+	// 3 is already the root of the max heap, and each of the two extractions swaps the root behind the heap
+	expected := Stats{Comparisons: 3, Swaps: 2}
+	if stats != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, stats)
+	}
+}
+
+func TestSortWithStatsOnEmptyData(t *testing.T) {
+	var data []int
+	sorts := map[string]func([]int, types.Comparator[int]) Stats{
+		"InsertionSort": InsertionSortWithStats[int],
+		"MergeSort":     MergeSortWithStats[int],
+		"QuickSort":     QuickSortWithStats[int],
+		"HeapSort":      HeapSortWithStats[int],
+	}
+	for name, sortFunc := range sorts {
+		if stats := sortFunc(data, types.DefaultComparator[int]{}); stats != (Stats{}) {
+			t.Errorf("%s: expected no work, but got %+v", name, stats)
+		}
+	}
+}
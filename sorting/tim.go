@@ -0,0 +1,485 @@
+package sorting
+
+import (
+	"github.com/lebruchette/algos/types"
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	// minMerge is the length below which a slice is sorted with binary insertion sort alone, and the upper bound on
+	// the minimum run length
+	minMerge = 32
+	// minGallop is the initial number of consecutive wins by one run after which a merge switches to galloping mode
+	minGallop = 7
+)
+
+// TimSort sorts a slice of ordered types in ascending order using TimSort, a stable merge sort that finds the runs
+// already present in the data and merges them, so that nearly sorted input and concatenations of sorted runs sort in
+// close to linear time.  It uses types.OrderedComparator, so floats are totally ordered with NaNs first and -0 before
+// +0.  It allocates a buffer of up to half the length of the slice.
+func TimSort[T constraints.Ordered](items []T) {
+	TimSortWithComparator(items, types.OrderedComparator[T]())
+}
+
+// TimSortWithComparator sorts a slice of any type using TimSort and a custom comparator, like TimSort.  Elements the
+// comparator considers equal keep their original order.
+func TimSortWithComparator[T any](items []T, comparator types.Comparator[T]) {
+	if len(items) < 2 {
+		return
+	}
+	ts := &timSort[T]{items: items, compare: types.CompareFunc(comparator), minGallop: minGallop}
+	ts.sort()
+}
+
+// run is a sorted stretch of the slice, pending a merge with its neighbours.
+type run struct {
+	base, length int
+}
+
+// timSort holds the state of a single TimSort, after Tim Peters' listsort as described in CPython's listsort.txt and
+// implemented in Java's java.util.TimSort.
+type timSort[T any] struct {
+	items   []T
+	compare func(a, b T) int
+	// minGallop adapts to the data: it shrinks while galloping pays off and grows while it does not
+	minGallop int
+	// tmp holds a copy of the smaller run of a merge
+	tmp []T
+	// runs is the stack of runs pending a merge, see mergeCollapse for the invariants it maintains
+	runs []run
+}
+
+func (ts *timSort[T]) sort() {
+	n := len(ts.items)
+	minRun := minRunLength(n)
+	for lo := 0; lo < n; {
+		// extend short natural runs to minRun elements with binary insertion sort
+		runLength := ts.countRunAndMakeAscending(lo, n)
+		if runLength < minRun {
+			forced := min(minRun, n-lo)
+			ts.binaryInsertionSort(lo, lo+forced, lo+runLength)
+			runLength = forced
+		}
+
+		ts.runs = append(ts.runs, run{base: lo, length: runLength})
+		ts.mergeCollapse()
+		lo += runLength
+	}
+	ts.mergeForceCollapse()
+}
+
+// minRunLength returns the minimum run length for a slice of n elements: n itself if it is shorter than minMerge,
+// otherwise a length between minMerge/2 and minMerge such that n/minRun is a power of two or slightly less than one,
+// which keeps the final merges balanced.
+func minRunLength(n int) int {
+	r := 0
+	for n >= minMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// countRunAndMakeAscending returns the length of the run starting at lo, reversing it in place if it is descending.
+// Descending runs must be strictly descending, so that reversing them keeps equal elements in order.
+func (ts *timSort[T]) countRunAndMakeAscending(lo, hi int) int {
+	items := ts.items
+	runHi := lo + 1
+	if runHi == hi {
+		return 1
+	}
+
+	if ts.compare(items[runHi], items[lo]) < 0 {
+		runHi++
+		for runHi < hi && ts.compare(items[runHi], items[runHi-1]) < 0 {
+			runHi++
+		}
+		reverseRange(items, lo, runHi)
+	} else {
+		runHi++
+		for runHi < hi && ts.compare(items[runHi], items[runHi-1]) >= 0 {
+			runHi++
+		}
+	}
+	return runHi - lo
+}
+
+// binaryInsertionSort sorts items[lo:hi], of which items[lo:start] is already sorted, inserting each further element
+// after any equal elements.
+func (ts *timSort[T]) binaryInsertionSort(lo, hi, start int) {
+	items := ts.items
+	for ; start < hi; start++ {
+		pivot := items[start]
+		left, right := lo, start
+		for left < right {
+			mid := int(uint(left+right) >> 1)
+			if ts.compare(pivot, items[mid]) < 0 {
+				right = mid
+			} else {
+				left = mid + 1
+			}
+		}
+		copy(items[left+1:start+1], items[left:start])
+		items[left] = pivot
+	}
+}
+
+// mergeCollapse merges runs at the top of the stack until, for the lengths A, B, C and D of the top four runs (D on
+// top), A > B + C, B > C + D and C > D hold.  This keeps the run lengths growing at least as fast as the Fibonacci
+// numbers down the stack, so the stack stays short and merges stay balanced.  Checking the fourth run as well as the
+// third is the fix to the invariant found by de Gouw et al. in 2015.
+func (ts *timSort[T]) mergeCollapse() {
+	for len(ts.runs) > 1 {
+		n := len(ts.runs) - 2
+		runs := ts.runs
+		if (n > 0 && runs[n-1].length <= runs[n].length+runs[n+1].length) ||
+			(n > 1 && runs[n-2].length <= runs[n-1].length+runs[n].length) {
+			if runs[n-1].length < runs[n+1].length {
+				n--
+			}
+		} else if runs[n].length > runs[n+1].length {
+			return
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges all the runs on the stack into one, once all runs have been found.
+func (ts *timSort[T]) mergeForceCollapse() {
+	for len(ts.runs) > 1 {
+		n := len(ts.runs) - 2
+		if n > 0 && ts.runs[n-1].length < ts.runs[n+1].length {
+			n--
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at indices i and i+1 of the stack, which must be one of the top two pairs.
+func (ts *timSort[T]) mergeAt(i int) {
+	base1, length1 := ts.runs[i].base, ts.runs[i].length
+	base2, length2 := ts.runs[i+1].base, ts.runs[i+1].length
+
+	ts.runs[i].length = length1 + length2
+	if i == len(ts.runs)-3 {
+		ts.runs[i+1] = ts.runs[i+2]
+	}
+	ts.runs = ts.runs[:len(ts.runs)-1]
+
+	// elements of the first run that are no greater than the first element of the second are already in place
+	k := gallopRight(ts.items[base2], ts.items[base1:base1+length1], 0, ts.compare)
+	base1 += k
+	length1 -= k
+	if length1 == 0 {
+		return
+	}
+
+	// as are elements of the second run that are no less than the last element of the first
+	length2 = gallopLeft(ts.items[base1+length1-1], ts.items[base2:base2+length2], length2-1, ts.compare)
+	if length2 == 0 {
+		return
+	}
+
+	if length1 <= length2 {
+		ts.mergeLo(base1, length1, base2, length2)
+	} else {
+		ts.mergeHi(base1, length1, base2, length2)
+	}
+}
+
+// gallopLeft returns the index at which key would be inserted into the sorted slice before any equal elements,
+// searching outwards from hint with exponentially growing steps before searching the last step with binary search.
+func gallopLeft[T any](key T, sorted []T, hint int, compare func(a, b T) int) int {
+	lastOffset, offset := 0, 1
+	if compare(key, sorted[hint]) > 0 {
+		// gallop right until sorted[hint+lastOffset] < key <= sorted[hint+offset]
+		maxOffset := len(sorted) - hint
+		for offset < maxOffset && compare(key, sorted[hint+offset]) > 0 {
+			lastOffset = offset
+			offset = offset<<1 + 1
+		}
+		offset = min(offset, maxOffset)
+		lastOffset, offset = lastOffset+hint, offset+hint
+	} else {
+		// gallop left until sorted[hint-offset] < key <= sorted[hint-lastOffset]
+		maxOffset := hint + 1
+		for offset < maxOffset && compare(key, sorted[hint-offset]) <= 0 {
+			lastOffset = offset
+			offset = offset<<1 + 1
+		}
+		offset = min(offset, maxOffset)
+		lastOffset, offset = hint-offset, hint-lastOffset
+	}
+
+	// sorted[lastOffset] < key <= sorted[offset], so binary search the range in between
+	lastOffset++
+	for lastOffset < offset {
+		mid := lastOffset + (offset-lastOffset)>>1
+		if compare(key, sorted[mid]) > 0 {
+			lastOffset = mid + 1
+		} else {
+			offset = mid
+		}
+	}
+	return offset
+}
+
+// gallopRight is gallopLeft, except that it returns the index after any elements equal to key.
+func gallopRight[T any](key T, sorted []T, hint int, compare func(a, b T) int) int {
+	lastOffset, offset := 0, 1
+	if compare(key, sorted[hint]) < 0 {
+		// gallop left until sorted[hint-offset] <= key < sorted[hint-lastOffset]
+		maxOffset := hint + 1
+		for offset < maxOffset && compare(key, sorted[hint-offset]) < 0 {
+			lastOffset = offset
+			offset = offset<<1 + 1
+		}
+		offset = min(offset, maxOffset)
+		lastOffset, offset = hint-offset, hint-lastOffset
+	} else {
+		// gallop right until sorted[hint+lastOffset] <= key < sorted[hint+offset]
+		maxOffset := len(sorted) - hint
+		for offset < maxOffset && compare(key, sorted[hint+offset]) >= 0 {
+			lastOffset = offset
+			offset = offset<<1 + 1
+		}
+		offset = min(offset, maxOffset)
+		lastOffset, offset = lastOffset+hint, offset+hint
+	}
+
+	// sorted[lastOffset] <= key < sorted[offset], so binary search the range in between
+	lastOffset++
+	for lastOffset < offset {
+		mid := lastOffset + (offset-lastOffset)>>1
+		if compare(key, sorted[mid]) < 0 {
+			offset = mid
+		} else {
+			lastOffset = mid + 1
+		}
+	}
+	return offset
+}
+
+// mergeLo merges two adjacent runs, the first of which is no longer than the second, from the left.  The first run
+// is copied to tmp and merged into the space it leaves.  The first element of the second run must be less than the
+// first element of the first, and the last element of the first run greater than every element of the second, as
+// mergeAt ensures.
+func (ts *timSort[T]) mergeLo(base1, length1, base2, length2 int) {
+	items := ts.items
+	tmp := ts.ensureCapacity(length1)
+	copy(tmp, items[base1:base1+length1])
+	cursor1, cursor2, dest := 0, base2, base1
+
+	items[dest] = items[cursor2]
+	dest++
+	cursor2++
+	length2--
+	if length2 == 0 {
+		copy(items[dest:], tmp[cursor1:cursor1+length1])
+		return
+	}
+	if length1 == 1 {
+		copy(items[dest:], items[cursor2:cursor2+length2])
+		items[dest+length2] = tmp[cursor1]
+		return
+	}
+
+	gallopThreshold := ts.minGallop
+outer:
+	for {
+		// count how many times in a row each run has supplied the next element
+		count1, count2 := 0, 0
+		for count1|count2 < gallopThreshold {
+			if ts.compare(items[cursor2], tmp[cursor1]) < 0 {
+				items[dest] = items[cursor2]
+				dest++
+				cursor2++
+				count1, count2 = 0, count2+1
+				length2--
+				if length2 == 0 {
+					break outer
+				}
+			} else {
+				items[dest] = tmp[cursor1]
+				dest++
+				cursor1++
+				count1, count2 = count1+1, 0
+				length1--
+				if length1 == 1 {
+					break outer
+				}
+			}
+		}
+
+		// one run is winning consistently, so gallop to find how many elements it wins in one go, until galloping
+		// stops paying off
+		for {
+			count1 = gallopRight(items[cursor2], tmp[cursor1:cursor1+length1], 0, ts.compare)
+			if count1 != 0 {
+				copy(items[dest:], tmp[cursor1:cursor1+count1])
+				dest += count1
+				cursor1 += count1
+				length1 -= count1
+				if length1 <= 1 {
+					break outer
+				}
+			}
+			items[dest] = items[cursor2]
+			dest++
+			cursor2++
+			length2--
+			if length2 == 0 {
+				break outer
+			}
+
+			count2 = gallopLeft(tmp[cursor1], items[cursor2:cursor2+length2], 0, ts.compare)
+			if count2 != 0 {
+				copy(items[dest:], items[cursor2:cursor2+count2])
+				dest += count2
+				cursor2 += count2
+				length2 -= count2
+				if length2 == 0 {
+					break outer
+				}
+			}
+			items[dest] = tmp[cursor1]
+			dest++
+			cursor1++
+			length1--
+			if length1 == 1 {
+				break outer
+			}
+
+			gallopThreshold--
+			if count1 < minGallop && count2 < minGallop {
+				break
+			}
+		}
+		// penalise leaving galloping mode
+		gallopThreshold = max(gallopThreshold, 0) + 2
+	}
+	ts.minGallop = max(gallopThreshold, 1)
+
+	if length1 == 1 {
+		copy(items[dest:], items[cursor2:cursor2+length2])
+		items[dest+length2] = tmp[cursor1]
+	} else {
+		// length1 can only be 0 here if the comparator is inconsistent; copying nothing still leaves a permutation
+		copy(items[dest:], tmp[cursor1:cursor1+length1])
+	}
+}
+
+// mergeHi is mergeLo from the right, for when the second run is the shorter one.  The second run is copied to tmp and
+// the runs are merged into the space it leaves, from the end backwards.
+func (ts *timSort[T]) mergeHi(base1, length1, base2, length2 int) {
+	items := ts.items
+	tmp := ts.ensureCapacity(length2)
+	copy(tmp, items[base2:base2+length2])
+	cursor1, cursor2, dest := base1+length1-1, length2-1, base2+length2-1
+
+	items[dest] = items[cursor1]
+	dest--
+	cursor1--
+	length1--
+	if length1 == 0 {
+		copy(items[dest-(length2-1):], tmp[:length2])
+		return
+	}
+	if length2 == 1 {
+		dest -= length1
+		cursor1 -= length1
+		copy(items[dest+1:], items[cursor1+1:cursor1+1+length1])
+		items[dest] = tmp[cursor2]
+		return
+	}
+
+	gallopThreshold := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0
+		for count1|count2 < gallopThreshold {
+			if ts.compare(tmp[cursor2], items[cursor1]) < 0 {
+				items[dest] = items[cursor1]
+				dest--
+				cursor1--
+				count1, count2 = count1+1, 0
+				length1--
+				if length1 == 0 {
+					break outer
+				}
+			} else {
+				items[dest] = tmp[cursor2]
+				dest--
+				cursor2--
+				count1, count2 = 0, count2+1
+				length2--
+				if length2 == 1 {
+					break outer
+				}
+			}
+		}
+
+		for {
+			count1 = length1 - gallopRight(tmp[cursor2], items[base1:base1+length1], length1-1, ts.compare)
+			if count1 != 0 {
+				dest -= count1
+				cursor1 -= count1
+				length1 -= count1
+				copy(items[dest+1:], items[cursor1+1:cursor1+1+count1])
+				if length1 == 0 {
+					break outer
+				}
+			}
+			items[dest] = tmp[cursor2]
+			dest--
+			cursor2--
+			length2--
+			if length2 == 1 {
+				break outer
+			}
+
+			count2 = length2 - gallopLeft(items[cursor1], tmp[:length2], length2-1, ts.compare)
+			if count2 != 0 {
+				dest -= count2
+				cursor2 -= count2
+				length2 -= count2
+				copy(items[dest+1:], tmp[cursor2+1:cursor2+1+count2])
+				if length2 <= 1 {
+					break outer
+				}
+			}
+			items[dest] = items[cursor1]
+			dest--
+			cursor1--
+			length1--
+			if length1 == 0 {
+				break outer
+			}
+
+			gallopThreshold--
+			if count1 < minGallop && count2 < minGallop {
+				break
+			}
+		}
+		gallopThreshold = max(gallopThreshold, 0) + 2
+	}
+	ts.minGallop = max(gallopThreshold, 1)
+
+	if length2 == 1 {
+		dest -= length1
+		cursor1 -= length1
+		copy(items[dest+1:], items[cursor1+1:cursor1+1+length1])
+		items[dest] = tmp[cursor2]
+	} else {
+		// length2 can only be 0 here if the comparator is inconsistent; copying nothing still leaves a permutation
+		copy(items[dest-(length2-1):], tmp[:length2])
+	}
+}
+
+// ensureCapacity returns a buffer of at least n elements, growing tmp geometrically up to half the slice length.
+func (ts *timSort[T]) ensureCapacity(n int) []T {
+	if len(ts.tmp) < n {
+		ts.tmp = make([]T, max(n, min(2*len(ts.tmp), len(ts.items)/2)))
+	}
+	return ts.tmp[:n]
+}
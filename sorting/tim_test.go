@@ -0,0 +1,158 @@
+package sorting
+
+import (
+	"github.com/lebruchette/algos/types"
+	"math"
+	"math/rand"
+	"reflect"
+	golangSort "sort"
+	"testing"
+	"time"
+)
+
+func TestTimSortWithInts(t *testing.T) {
+	data := []int{5, 2, 9, 1, 5, 6}
+	expected := []int{1, 2, 5, 5, 6, 9}
+
+	TimSort(data)
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestTimSortWithComparator(t *testing.T) {
+	bob := types.Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	frank := types.Person{Name: "Frank", Dob: time.Date(1992, time.September, 25, 0, 0, 0, 0, time.UTC)}
+	alice := types.Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	diana := types.Person{Name: "Diana", Dob: time.Date(1995, time.December, 5, 0, 0, 0, 0, time.UTC)}
+	charlie := types.Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := types.Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	data := []types.Person{bob, frank, alice, diana, charlie, eve}
+	expected := []types.Person{bob, eve, alice, frank, diana, charlie}
+
+	TimSortWithComparator[types.Person](data, types.PersonComparator{})
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestTimSortWithNaNAndSignedZeros(t *testing.T) {
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+	data := []float64{3, nan, 0, 1, nan, negativeZero, -2}
+	expected := floatBits([]float64{nan, nan, -2, negativeZero, 0, 1, 3})
+
+	TimSort(data)
+
+	if got := floatBits(data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestTimSortMatchesStandardLibrary(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, pattern := range sortPatterns {
+		for _, n := range []int{0, 1, 2, 31, 32, 33, 64, 100, 1000, 10000} {
+			data := pattern.generate(random, n)
+			expected := make([]int, n)
+			copy(expected, data)
+			golangSort.Ints(expected)
+
+			TimSort(data)
+
+			if !reflect.DeepEqual(data, expected) {
+				t.Errorf("%s/%d: expected %v, but got %v", pattern.name, n, expected, data)
+			}
+		}
+	}
+}
+
+// record is sorted by key only, with seq recording the original order to check stability.
+type record struct {
+	key, seq int
+}
+
+func TestTimSortIsStable(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	byKey := types.ByKey(func(r record) int { return r.key })
+	for _, n := range []int{10, 100, 1000, 10000, 50000} {
+		for _, unique := range []int{2, 10, n / 2} {
+			data := make([]record, n)
+			for i := range data {
+				data[i] = record{key: random.Intn(unique), seq: i}
+			}
+			// descending and ascending stretches, so that runs are reversed and merged as well as insertion sorted
+			golangSort.SliceStable(data[:n/3], func(i, j int) bool { return data[i].key > data[j].key })
+			golangSort.SliceStable(data[n/3:2*n/3], func(i, j int) bool { return data[n/3+i].key < data[n/3+j].key })
+			expected := make([]record, n)
+			copy(expected, data)
+			golangSort.SliceStable(expected, func(i, j int) bool { return expected[i].key < expected[j].key })
+
+			TimSortWithComparator(data, byKey)
+
+			if !reflect.DeepEqual(data, expected) {
+				t.Fatalf("%d elements with %d unique keys: expected a stable sort", n, unique)
+			}
+		}
+	}
+}
+
+func TestTimSortMergesSortedRunsInLinearTime(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	// eight shards of sorted, interleaved keys, like concatenated log files
+	const shards, shardLength = 8, 5000
+	var data []int
+	for shard := 0; shard < shards; shard++ {
+		key := 0
+		for i := 0; i < shardLength; i++ {
+			key += random.Intn(10)
+			data = append(data, key)
+		}
+	}
+	expected := make([]int, len(data))
+	copy(expected, data)
+	golangSort.Ints(expected)
+	counter := types.NewCountingComparator[int](types.DefaultComparator[int]{})
+
+	TimSortWithComparator[int](data, counter)
+
+	if !reflect.DeepEqual(data, expected) {
+		t.Fatalf("Expected the shards to be merged into sorted order")
+	}
+	// finding the runs takes n comparisons, and each element takes part in log2(shards) merges
+	if limit := len(data) * 5; counter.Total() > limit {
+		t.Errorf("Expected at most %d comparisons, but got %d", limit, counter.Total())
+	}
+}
+
+func TestTimSortGallopsThroughDisjointRuns(t *testing.T) {
+	// two sorted runs whose ranges do not overlap, in the wrong order
+	data := make([]int, 20000)
+	for i := range data {
+		data[i] = (i + len(data)/2) % len(data)
+	}
+	counter := types.NewCountingComparator[int](types.DefaultComparator[int]{})
+
+	TimSortWithComparator[int](data, counter)
+
+	for i := range data {
+		if data[i] != i {
+			t.Fatalf("Expected %d at index %d, but got %d", i, i, data[i])
+		}
+	}
+	// apart from finding the runs, galloping merges them with a logarithmic number of comparisons
+	if limit := len(data) + 100; counter.Total() > limit {
+		t.Errorf("Expected at most %d comparisons, but got %d", limit, counter.Total())
+	}
+}
+
+func TestMinRunLength(t *testing.T) {
+	cases := map[int]int{0: 0, 1: 1, 31: 31, 32: 16, 33: 17, 64: 16, 65: 17, 100: 25, 1 << 20: 16, 1<<20 + 1: 17}
+	for n, expected := range cases {
+		if got := minRunLength(n); got != expected {
+			t.Errorf("Expected minRunLength(%d) to be %d, but got %d", n, expected, got)
+		}
+	}
+}
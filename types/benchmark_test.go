@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+type testCase struct {
+	name string
+	n    int
+}
+
+var iteration = []testCase{
+	{"1000", 1000},
+	{"10000", 10000},
+	{"100000", 100000},
+}
+
+var arities = []int{2, 4, 8}
+
+func BenchmarkDaryHeapPush(b *testing.B) {
+	runDaryHeapBenchmark(b, func(heap *Heap[int], items []int) {
+		for _, item := range items {
+			heap.Push(item)
+		}
+	})
+}
+
+func BenchmarkDaryHeapPushPop(b *testing.B) {
+	runDaryHeapBenchmark(b, func(heap *Heap[int], items []int) {
+		for _, item := range items {
+			heap.Push(item)
+		}
+		for heap.Len() > 0 {
+			heap.Pop()
+		}
+	})
+}
+
+func runDaryHeapBenchmark(b *testing.B, heapFunc func(*Heap[int], []int)) {
+	for _, arity := range arities {
+		for _, tc := range iteration {
+			b.Run(fmt.Sprintf("%d-ary/%s", arity, tc.name), func(b *testing.B) {
+				items := make([]int, tc.n)
+				for i := range items {
+					items[i] = rand.Intn(tc.n)
+				}
+				heap := NewDaryHeap[int](arity, MinHeap, make([]int, 0, tc.n))
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					heap.Clear()
+					heapFunc(heap, items)
+				}
+			})
+		}
+	}
+}
+
+type dijkstraEdge struct {
+	to     int
+	weight uint32
+}
+
+// dijkstraItem is a tentative distance to a vertex, as queued by lazy-deletion Dijkstra.
+type dijkstraItem struct {
+	dist   uint32
+	vertex int
+}
+
+type dijkstraItemComparator struct{}
+
+func (d dijkstraItemComparator) GreaterThan(a, b dijkstraItem) bool { return a.dist > b.dist }
+func (d dijkstraItemComparator) LessThan(a, b dijkstraItem) bool    { return a.dist < b.dist }
+func (d dijkstraItemComparator) EqualTo(a, b dijkstraItem) bool     { return a.dist == b.dist }
+
+func BenchmarkDijkstraHeap(b *testing.B) {
+	runDijkstraBenchmark(b, func(graph [][]dijkstraEdge) []uint32 {
+		dist := newDistances(len(graph))
+		heap := NewHeapWithComparator[dijkstraItem](MinHeap, []dijkstraItem{{0, 0}}, dijkstraItemComparator{})
+		for item, ok := heap.Pop(); ok; item, ok = heap.Pop() {
+			if item.dist > dist[item.vertex] {
+				continue
+			}
+			for _, e := range graph[item.vertex] {
+				if candidate := item.dist + e.weight; candidate < dist[e.to] {
+					dist[e.to] = candidate
+					heap.Push(dijkstraItem{candidate, e.to})
+				}
+			}
+		}
+		return dist
+	})
+}
+
+func BenchmarkDijkstraRadixHeap(b *testing.B) {
+	runDijkstraBenchmark(b, func(graph [][]dijkstraEdge) []uint32 {
+		dist := newDistances(len(graph))
+		heap := NewRadixHeap[uint32, int]()
+		_ = heap.Push(0, 0)
+		for d, vertex, ok := heap.Pop(); ok; d, vertex, ok = heap.Pop() {
+			if d > dist[vertex] {
+				continue
+			}
+			for _, e := range graph[vertex] {
+				if candidate := d + e.weight; candidate < dist[e.to] {
+					dist[e.to] = candidate
+					_ = heap.Push(candidate, e.to)
+				}
+			}
+		}
+		return dist
+	})
+}
+
+func newDistances(n int) []uint32 {
+	dist := make([]uint32, n)
+	for i := range dist {
+		dist[i] = math.MaxUint32
+	}
+	dist[0] = 0
+	return dist
+}
+
+func runDijkstraBenchmark(b *testing.B, shortestPaths func([][]dijkstraEdge) []uint32) {
+	for _, tc := range iteration {
+		b.Run(tc.name, func(b *testing.B) {
+			// a random graph with 8 outgoing edges per vertex and weights up to 1000
+			graph := make([][]dijkstraEdge, tc.n)
+			for v := range graph {
+				for e := 0; e < 8; e++ {
+					graph[v] = append(graph[v], dijkstraEdge{to: rand.Intn(tc.n), weight: uint32(rand.Intn(1000))})
+				}
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				shortestPaths(graph)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package types
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// BinomialNode is an element of a BinomialHeap.  It is returned by Push and serves as the handle for DecreaseKey.
+type BinomialNode[T any] struct {
+	value T
+	// tree is the position the element currently occupies; DecreaseKey moves elements between positions, so the
+	// handle and the tree structure are kept separate.  It is nil once the element has been popped
+	tree *binomialTree[T]
+	// owner identifies the heap the node belongs to
+	owner *heapOwner
+}
+
+// Value returns the element held by the node.
+func (n *BinomialNode[T]) Value() T {
+	return n.value
+}
+
+// binomialTree is a position in a binomial tree.  child is the highest-degree child and sibling the next
+// lower-degree sibling, or for roots the next root in order of increasing degree.
+type binomialTree[T any] struct {
+	node                   *BinomialNode[T]
+	parent, child, sibling *binomialTree[T]
+	degree                 int
+}
+
+// BinomialHeap is a mergeable heap represented as a list of binomial trees of distinct degree.  Push, Pop, Meld
+// and DecreaseKey all run in O(log n).
+type BinomialHeap[T any] struct {
+	head     *binomialTree[T]
+	size     int
+	heapType HeapType
+	compare  func(a, b T) bool
+	owner    *heapOwner
+}
+
+// NewBinomialHeap creates a new, empty min or max binomial heap using the default comparator.
+func NewBinomialHeap[T constraints.Ordered](heapType HeapType) *BinomialHeap[T] {
+	return NewBinomialHeapWithComparator[T](heapType, DefaultComparator[T]{})
+}
+
+// NewBinomialHeapWithComparator creates a new, empty min or max binomial heap of any type using a custom comparator.
+func NewBinomialHeapWithComparator[T any](heapType HeapType, comparator Comparator[T]) *BinomialHeap[T] {
+	heap := &BinomialHeap[T]{heapType: heapType, compare: comparator.LessThan, owner: &heapOwner{}}
+	if heapType == MaxHeap {
+		heap.compare = comparator.GreaterThan
+	}
+	return heap
+}
+
+// Push adds a new item to the heap and returns its node, which can be passed to DecreaseKey.
+func (h *BinomialHeap[T]) Push(item T) *BinomialNode[T] {
+	node := &BinomialNode[T]{value: item, owner: h.owner}
+	node.tree = &binomialTree[T]{node: node}
+	h.union(node.tree)
+	h.size++
+	return node
+}
+
+// Pop removes and returns the root element of the heap.  The boolean result is false if the heap is empty.
+func (h *BinomialHeap[T]) Pop() (T, bool) {
+	prev, best := h.best()
+	if best == nil {
+		var zero T
+		return zero, false
+	}
+	if prev == nil {
+		h.head = best.sibling
+	} else {
+		prev.sibling = best.sibling
+	}
+
+	// the children of the removed root form a binomial heap of their own, in order of decreasing degree
+	var children *binomialTree[T]
+	for child := best.child; child != nil; {
+		next := child.sibling
+		child.parent, child.sibling = nil, children
+		children = child
+		child = next
+	}
+	h.union(children)
+	h.size--
+
+	best.node.tree = nil
+	return best.node.value, true
+}
+
+// Peek returns the root element of the heap without removing it.  The boolean result is false if the heap is empty.
+func (h *BinomialHeap[T]) Peek() (T, bool) {
+	if _, best := h.best(); best != nil {
+		return best.node.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Len returns the number of elements in the heap.
+func (h *BinomialHeap[T]) Len() int {
+	return h.size
+}
+
+// Meld moves every element of other into h in O(log n), leaving other empty.  Nodes returned by other.Push remain
+// valid handles into h.  It returns ErrHeapTypeMismatch if the heaps are not both min or both max heaps.
+func (h *BinomialHeap[T]) Meld(other *BinomialHeap[T]) error {
+	if h.heapType != other.heapType {
+		return ErrHeapTypeMismatch
+	}
+	if h == other {
+		return nil
+	}
+	h.union(other.head)
+	h.size += other.size
+	other.head, other.size = nil, 0
+	other.owner.next, other.owner = h.owner, &heapOwner{}
+	return nil
+}
+
+// DecreaseKey replaces the value of node with value, which must not rank after the current value (it must be
+// smaller or equal for a MinHeap, larger or equal for a MaxHeap), and moves the node towards the root.
+// It returns ErrInvalidKey if the new value ranks after the current one, or ErrNodeNotInHeap if the node has
+// already been popped or belongs to another heap.
+func (h *BinomialHeap[T]) DecreaseKey(node *BinomialNode[T], value T) error {
+	if node.tree == nil || node.owner.find() != h.owner {
+		return ErrNodeNotInHeap
+	}
+	if h.compare(node.value, value) {
+		return ErrInvalidKey
+	}
+	node.value = value
+
+	// bubble the node up by exchanging positions with its parent, keeping every handle pointing at its position
+	tree := node.tree
+	for tree.parent != nil && h.compare(tree.node.value, tree.parent.node.value) {
+		parent := tree.parent
+		tree.node, parent.node = parent.node, tree.node
+		tree.node.tree, parent.node.tree = tree, parent
+		tree = parent
+	}
+	return nil
+}
+
+// best returns the root ranking first among all roots, along with the root preceding it in the root list.
+func (h *BinomialHeap[T]) best() (prev, best *binomialTree[T]) {
+	var before *binomialTree[T]
+	for tree := h.head; tree != nil; before, tree = tree, tree.sibling {
+		if best == nil || h.compare(tree.node.value, best.node.value) {
+			prev, best = before, tree
+		}
+	}
+	return prev, best
+}
+
+// union merges the root list starting at other into the heap's root list, then links trees of equal degree so
+// that at most one tree of each degree remains.
+func (h *BinomialHeap[T]) union(other *binomialTree[T]) {
+	head := mergeRootLists(h.head, other)
+	if head == nil {
+		h.head = nil
+		return
+	}
+
+	var prev *binomialTree[T]
+	curr, next := head, head.sibling
+	for next != nil {
+		if curr.degree != next.degree || (next.sibling != nil && next.sibling.degree == curr.degree) {
+			// nothing to link here, or three trees of the same degree in a row, in which case link the latter two
+			prev, curr = curr, next
+		} else if !h.compare(next.node.value, curr.node.value) {
+			curr.sibling = next.sibling
+			linkBinomialTrees(next, curr)
+		} else {
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+			linkBinomialTrees(curr, next)
+			curr = next
+		}
+		next = curr.sibling
+	}
+	h.head = head
+}
+
+// mergeRootLists merges two root lists into a single list ordered by increasing degree.
+func mergeRootLists[T any](a, b *binomialTree[T]) *binomialTree[T] {
+	var head binomialTree[T]
+	tail := &head
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling, a = a, a.sibling
+		} else {
+			tail.sibling, b = b, b.sibling
+		}
+		tail = tail.sibling
+	}
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+	return head.sibling
+}
+
+// linkBinomialTrees makes child, a tree of the same degree as parent, the highest-degree child of parent.
+func linkBinomialTrees[T any](child, parent *binomialTree[T]) {
+	child.parent = parent
+	child.sibling = parent.child
+	parent.child = child
+	parent.degree++
+}
+
+// IsValidHeap checks if the heap property is maintained for the entire heap, and that the roots have strictly
+// increasing degrees.
+func (h *BinomialHeap[T]) IsValidHeap() bool {
+	count := 0
+	var valid func(tree *binomialTree[T]) bool
+	valid = func(tree *binomialTree[T]) bool {
+		count++
+		if tree.node.tree != tree {
+			return false
+		}
+		degree := 0
+		for child := tree.child; child != nil; child = child.sibling {
+			degree++
+			if child.parent != tree || h.compare(child.node.value, tree.node.value) || !valid(child) {
+				return false
+			}
+		}
+		return degree == tree.degree
+	}
+	for tree := h.head; tree != nil; tree = tree.sibling {
+		if tree.parent != nil || (tree.sibling != nil && tree.sibling.degree <= tree.degree) || !valid(tree) {
+			return false
+		}
+	}
+	return count == h.size
+}
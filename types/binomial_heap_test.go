@@ -0,0 +1,249 @@
+package types
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestBinomialHeapPushAndPop(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	for _, item := range []int{5, 2, 9, 1, 5, 6} {
+		heap.Push(item)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated")
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated after popping %v", item)
+		}
+		popped = append(popped, item)
+	}
+
+	expected := []int{1, 2, 5, 5, 6, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestBinomialHeapPopAndPeekEmpty(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	if _, ok := heap.Pop(); ok {
+		t.Errorf("Expected Pop on empty heap to return false")
+	}
+	if _, ok := heap.Peek(); ok {
+		t.Errorf("Expected Peek on empty heap to return false")
+	}
+}
+
+func TestBinomialHeapWithComparator(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	heap := NewBinomialHeapWithComparator[Person](MaxHeap, PersonComparator{})
+	heap.Push(bob)
+	heap.Push(charlie)
+	heap.Push(alice)
+
+	if item, _ := heap.Peek(); item != charlie {
+		t.Errorf("Expected %v, but got %v", charlie, item)
+	}
+}
+
+func TestBinomialHeapMeld(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	other := NewBinomialHeap[int](MinHeap)
+	for _, item := range []int{5, 9, 1} {
+		heap.Push(item)
+	}
+	node := other.Push(8)
+	other.Push(0)
+
+	if err := heap.Meld(other); err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+	if other.Len() != 0 {
+		t.Errorf("Expected melded heap to be empty, but got %d elements", other.Len())
+	}
+	// nodes of the melded heap remain valid handles
+	if err := heap.DecreaseKey(node, -1); err != nil {
+		t.Errorf("Unexpected error decreasing key: %v", err)
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		popped = append(popped, item)
+	}
+
+	expected := []int{-1, 0, 1, 5, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestBinomialHeapMeldHeapTypeMismatch(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	other := NewBinomialHeap[int](MaxHeap)
+	other.Push(1)
+
+	if err := heap.Meld(other); !errors.Is(err, ErrHeapTypeMismatch) {
+		t.Errorf("Expected %v, but got %v", ErrHeapTypeMismatch, err)
+	}
+	if other.Len() != 1 {
+		t.Errorf("Expected failed meld to leave the other heap untouched")
+	}
+}
+
+func TestBinomialHeapDecreaseKey(t *testing.T) {
+	heap := NewBinomialHeap[int](MaxHeap)
+	heap.Push(10)
+	node := heap.Push(3)
+	heap.Push(7)
+
+	if err := heap.DecreaseKey(node, 2); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Expected %v, but got %v", ErrInvalidKey, err)
+	}
+	if err := heap.DecreaseKey(node, 20); err != nil {
+		t.Errorf("Unexpected error decreasing key: %v", err)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated after decreasing key")
+	}
+
+	if item, _ := heap.Pop(); item != 20 || node.Value() != 20 {
+		t.Errorf("Expected 20, but got %v", item)
+	}
+	if err := heap.DecreaseKey(node, 30); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+}
+
+func TestBinomialHeapDecreaseKeyRejectsForeignNodes(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	other := NewBinomialHeap[int](MinHeap)
+	heap.Push(5)
+	foreign := other.Push(8)
+	other.Push(3)
+
+	if err := heap.DecreaseKey(foreign, 1); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+	if !heap.IsValidHeap() || !other.IsValidHeap() || foreign.Value() != 8 {
+		t.Errorf("Expected a rejected DecreaseKey to leave both heaps untouched")
+	}
+
+	// nodes follow their elements through a chain of melds, and an emptied heap's new nodes are its own
+	third := NewBinomialHeap[int](MinHeap)
+	if err := third.Meld(other); err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+	if err := heap.Meld(third); err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+	if err := heap.DecreaseKey(foreign, 1); err != nil {
+		t.Errorf("Unexpected error decreasing key of a melded node: %v", err)
+	}
+	if err := third.DecreaseKey(foreign, 0); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+	fresh := other.Push(9)
+	if err := heap.DecreaseKey(fresh, 0); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+	if item, _ := heap.Pop(); item != 1 || !heap.IsValidHeap() || !other.IsValidHeap() {
+		t.Errorf("Expected 1 from a valid heap, but got %v", item)
+	}
+}
+
+func TestBinomialHeapRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	heap := NewBinomialHeap[int](MinHeap)
+	other := NewBinomialHeap[int](MinHeap)
+	var nodes []*BinomialNode[int]
+	var expected []int
+
+	for i := 0; i < 500; i++ {
+		item := random.Intn(1000)
+		if i%2 == 0 {
+			nodes = append(nodes, heap.Push(item))
+		} else {
+			nodes = append(nodes, other.Push(item))
+		}
+	}
+	_ = heap.Meld(other)
+	for _, node := range nodes {
+		value := node.Value()
+		if random.Intn(2) == 0 {
+			value -= random.Intn(500)
+			if err := heap.DecreaseKey(node, value); err != nil {
+				t.Fatalf("Unexpected error decreasing key: %v", err)
+			}
+		}
+		expected = append(expected, value)
+	}
+	if !heap.IsValidHeap() {
+		t.Fatalf("Heap property violated")
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		popped = append(popped, item)
+	}
+
+	sort.Ints(expected)
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestBinomialHeapRootsMatchBinaryRepresentation(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	for i := 1; i <= 13; i++ {
+		heap.Push(i)
+	}
+
+	// 13 = 0b1101, so there is exactly one tree each of degree 0, 2 and 3
+	var degrees []int
+	for tree := heap.head; tree != nil; tree = tree.sibling {
+		degrees = append(degrees, tree.degree)
+	}
+
+	expected := []int{0, 2, 3}
+	if !reflect.DeepEqual(degrees, expected) {
+		t.Errorf("Expected root degrees %v, but got %v", expected, degrees)
+	}
+}
+
+func TestBinomialHeapDecreaseKeyKeepsHandles(t *testing.T) {
+	heap := NewBinomialHeap[int](MinHeap)
+	var nodes []*BinomialNode[int]
+	for i := 0; i < 8; i++ {
+		nodes = append(nodes, heap.Push(10*i))
+	}
+
+	// the deepest node of the single degree-3 tree climbs all the way to the root, displacing its ancestors
+	if err := heap.DecreaseKey(nodes[7], -5); err != nil {
+		t.Fatalf("Unexpected error decreasing key: %v", err)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated after decreasing key")
+	}
+	for i, node := range nodes[:7] {
+		if node.Value() != 10*i || node.tree.node != node {
+			t.Errorf("Expected handle %d to still hold %d, but got %d", i, 10*i, node.Value())
+		}
+	}
+	if item, _ := heap.Peek(); item != -5 {
+		t.Errorf("Expected -5, but got %v", item)
+	}
+}
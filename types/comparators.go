@@ -0,0 +1,139 @@
+package types
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// FromCompareFunc returns a comparator backed by a three-way comparison function such as cmp.Compare or
+// strings.Compare, which returns a negative number, zero or a positive number when a is less than, equal to or greater
+// than b.
+func FromCompareFunc[T any](compare func(a, b T) int) Comparator[T] {
+	return compareFunc[T](compare)
+}
+
+// CompareFunc returns the three-way comparison function for c, suitable for slices.SortFunc and friends.  The
+// comparator's own Compare method is used if it implements ThreeWayComparator.
+func CompareFunc[T any](c Comparator[T]) func(a, b T) int {
+	if threeWay, ok := c.(ThreeWayComparator[T]); ok {
+		return threeWay.Compare
+	}
+	return func(a, b T) int { return compare(c, a, b) }
+}
+
+// compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b according to c.
+func compare[T any](c Comparator[T], a, b T) int {
+	if threeWay, ok := c.(ThreeWayComparator[T]); ok {
+		return threeWay.Compare(a, b)
+	}
+	switch {
+	case c.LessThan(a, b):
+		return -1
+	case c.GreaterThan(a, b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareFunc builds the three Comparator methods out of a single three-way comparison.
+type compareFunc[T any] func(a, b T) int
+
+func (f compareFunc[T]) GreaterThan(a, b T) bool { return f(a, b) > 0 }
+func (f compareFunc[T]) LessThan(a, b T) bool    { return f(a, b) < 0 }
+func (f compareFunc[T]) EqualTo(a, b T) bool     { return f(a, b) == 0 }
+func (f compareFunc[T]) Compare(a, b T) int      { return f(a, b) }
+
+// Reverse returns a comparator that orders elements in the opposite order to c.
+func Reverse[T any](c Comparator[T]) Comparator[T] {
+	return reverseComparator[T]{c}
+}
+
+type reverseComparator[T any] struct {
+	comparator Comparator[T]
+}
+
+func (r reverseComparator[T]) GreaterThan(a, b T) bool { return r.comparator.LessThan(a, b) }
+func (r reverseComparator[T]) LessThan(a, b T) bool    { return r.comparator.GreaterThan(a, b) }
+func (r reverseComparator[T]) EqualTo(a, b T) bool     { return r.comparator.EqualTo(a, b) }
+func (r reverseComparator[T]) Compare(a, b T) int      { return compare(r.comparator, b, a) }
+
+// ThenBy returns a comparator that orders elements by the first comparator, breaking ties with the second, then the
+// third and so on.  Elements are equal only if every comparator considers them equal.
+//
+//	// sort people by date of birth, youngest first, then by name
+//	byAgeThenName := types.ThenBy(types.Reverse[types.Person](types.PersonComparator{}),
+//		types.ByKey(func(p types.Person) string { return p.Name }))
+func ThenBy[T any](comparators ...Comparator[T]) Comparator[T] {
+	return compareFunc[T](func(a, b T) int {
+		for _, c := range comparators {
+			if result := compare(c, a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+}
+
+// ByKey returns a comparator that orders elements by the ordered key extracted from each of them.
+func ByKey[T any, K constraints.Ordered](key func(T) K) Comparator[T] {
+	return ByKeyWithComparator(key, Comparator[K](DefaultComparator[K]{}))
+}
+
+// ByKeyWithComparator returns a comparator that orders elements by the key extracted from each of them, using a
+// custom comparator for the keys.
+func ByKeyWithComparator[T, K any](key func(T) K, comparator Comparator[K]) Comparator[T] {
+	return byKeyComparator[T, K]{key: key, comparator: comparator}
+}
+
+type byKeyComparator[T, K any] struct {
+	key        func(T) K
+	comparator Comparator[K]
+}
+
+func (b byKeyComparator[T, K]) GreaterThan(x, y T) bool {
+	return b.comparator.GreaterThan(b.key(x), b.key(y))
+}
+func (b byKeyComparator[T, K]) LessThan(x, y T) bool {
+	return b.comparator.LessThan(b.key(x), b.key(y))
+}
+func (b byKeyComparator[T, K]) EqualTo(x, y T) bool {
+	return b.comparator.EqualTo(b.key(x), b.key(y))
+}
+func (b byKeyComparator[T, K]) Compare(x, y T) int {
+	return compare(b.comparator, b.key(x), b.key(y))
+}
+
+// NilsFirst returns a comparator for pointers that orders nil before any non-nil pointer, and compares non-nil
+// pointers by the values they point to using c.  Two nil pointers are equal.
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return nilAwareComparator[T]{comparator: c, nilOrder: -1}
+}
+
+// NilsLast returns a comparator for pointers that orders nil after any non-nil pointer, and compares non-nil
+// pointers by the values they point to using c.  Two nil pointers are equal.
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	return nilAwareComparator[T]{comparator: c, nilOrder: 1}
+}
+
+type nilAwareComparator[T any] struct {
+	comparator Comparator[T]
+	// nilOrder is the result of comparing nil with a non-nil pointer
+	nilOrder int
+}
+
+func (n nilAwareComparator[T]) Compare(a, b *T) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return n.nilOrder
+	case b == nil:
+		return -n.nilOrder
+	default:
+		return compare(n.comparator, *a, *b)
+	}
+}
+
+func (n nilAwareComparator[T]) GreaterThan(a, b *T) bool { return n.Compare(a, b) > 0 }
+func (n nilAwareComparator[T]) LessThan(a, b *T) bool    { return n.Compare(a, b) < 0 }
+func (n nilAwareComparator[T]) EqualTo(a, b *T) bool     { return n.Compare(a, b) == 0 }
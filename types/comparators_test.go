@@ -0,0 +1,176 @@
+package types
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sortWith[T any](data []T, comparator Comparator[T]) []T {
+	sorted := append([]T(nil), data...)
+	sort.SliceStable(sorted, func(i, j int) bool { return comparator.LessThan(sorted[i], sorted[j]) })
+	return sorted
+}
+
+func TestReverse(t *testing.T) {
+	comparator := Reverse[int](DefaultComparator[int]{})
+
+	if !comparator.LessThan(5, 3) || comparator.GreaterThan(5, 3) || !comparator.EqualTo(4, 4) {
+		t.Errorf("Expected Reverse to swap LessThan and GreaterThan")
+	}
+
+	got := sortWith([]int{3, 1, 4, 1, 5}, comparator)
+	expected := []int{5, 4, 3, 1, 1}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestThenBy(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	erin := Person{Name: "Erin", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	carol := Person{Name: "Carol", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	dave := Person{Name: "Dave", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	comparator := ThenBy(Reverse[Person](PersonComparator{}), ByKey(func(p Person) string { return p.Name }))
+
+	got := sortWith([]Person{erin, carol, bob, dave, alice}, comparator)
+	expected := []Person{dave, alice, carol, bob, erin}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if !comparator.EqualTo(bob, bob) || comparator.EqualTo(bob, erin) {
+		t.Errorf("Expected elements to be equal only when every comparator considers them equal")
+	}
+	if !comparator.GreaterThan(erin, bob) || comparator.GreaterThan(bob, erin) {
+		t.Errorf("Expected ties on the first comparator to be broken by the second")
+	}
+}
+
+func TestThenByWithNoComparators(t *testing.T) {
+	comparator := ThenBy[int]()
+
+	if !comparator.EqualTo(1, 2) || comparator.LessThan(1, 2) || comparator.GreaterThan(2, 1) {
+		t.Errorf("Expected every element to be equal with no comparators")
+	}
+}
+
+func TestByKey(t *testing.T) {
+	comparator := ByKey(func(s string) int { return len(s) })
+
+	got := sortWith([]string{"ccc", "a", "bb", "dddd", "e"}, comparator)
+	expected := []string{"a", "e", "bb", "ccc", "dddd"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if !comparator.EqualTo("a", "e") {
+		t.Errorf("Expected strings of the same length to be equal")
+	}
+}
+
+func TestByKeyWithComparator(t *testing.T) {
+	type account struct {
+		owner Person
+	}
+	older := account{owner: Person{Name: "Older", Dob: time.Date(1970, time.May, 1, 0, 0, 0, 0, time.UTC)}}
+	younger := account{owner: Person{Name: "Younger", Dob: time.Date(1999, time.May, 1, 0, 0, 0, 0, time.UTC)}}
+
+	comparator := ByKeyWithComparator(func(a account) Person { return a.owner }, Comparator[Person](PersonComparator{}))
+
+	got := sortWith([]account{younger, older}, comparator)
+	expected := []account{older, younger}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestNilsFirstAndNilsLast(t *testing.T) {
+	one, two, three := 1, 2, 3
+	data := []*int{&three, nil, &one, nil, &two}
+
+	got := sortWith(data, NilsFirst[int](DefaultComparator[int]{}))
+	expected := []*int{nil, nil, &one, &two, &three}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	got = sortWith(data, NilsLast[int](DefaultComparator[int]{}))
+	expected = []*int{&one, &two, &three, nil, nil}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	comparator := NilsLast[int](DefaultComparator[int]{})
+	if !comparator.EqualTo(nil, nil) || comparator.EqualTo(nil, &one) {
+		t.Errorf("Expected nil to be equal only to nil")
+	}
+	if !comparator.EqualTo(&one, &[]int{1}[0]) {
+		t.Errorf("Expected distinct pointers to equal values to be equal")
+	}
+}
+
+func TestReverseNilsFirst(t *testing.T) {
+	one, two := 1, 2
+	comparator := Reverse(NilsFirst[int](DefaultComparator[int]{}))
+
+	got := sortWith([]*int{nil, &one, &two}, comparator)
+	expected := []*int{&two, &one, nil}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestFromCompareFunc(t *testing.T) {
+	comparator := FromCompareFunc(strings.Compare)
+
+	if !comparator.LessThan("apple", "banana") || !comparator.GreaterThan("b", "a") || !comparator.EqualTo("a", "a") {
+		t.Errorf("Expected the comparator to follow strings.Compare")
+	}
+
+	got := sortWith([]string{"pear", "apple", "fig"}, comparator)
+	expected := []string{"apple", "fig", "pear"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	dave := Person{Name: "Dave", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	// PersonComparator only implements the three boolean methods
+	data := []Person{dave, bob, alice}
+	slices.SortFunc(data, CompareFunc[Person](PersonComparator{}))
+
+	expected := []Person{bob, alice, dave}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, data)
+	}
+}
+
+func TestCompareFuncMatchesCmpCompare(t *testing.T) {
+	comparators := map[string]Comparator[int]{
+		"DefaultComparator": DefaultComparator[int]{},
+		"FromCompareFunc":   FromCompareFunc(cmp.Compare[int]),
+		"Reverse(Reverse)":  Reverse(Reverse[int](DefaultComparator[int]{})),
+		"ThenBy":            ThenBy[int](DefaultComparator[int]{}),
+		"ByKey":             ByKey(func(i int) int { return i }),
+	}
+	for name, comparator := range comparators {
+		if _, ok := comparator.(ThreeWayComparator[int]); !ok {
+			t.Errorf("Expected %s to implement ThreeWayComparator", name)
+		}
+		compare := CompareFunc(comparator)
+		for _, pair := range [][2]int{{1, 2}, {2, 1}, {3, 3}, {-5, 5}} {
+			if got, expected := compare(pair[0], pair[1]), cmp.Compare(pair[0], pair[1]); got != expected {
+				t.Errorf("%s: expected compare(%d, %d) to be %d, but got %d", name, pair[0], pair[1], expected, got)
+			}
+		}
+	}
+}
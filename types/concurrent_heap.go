@@ -0,0 +1,147 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrQueueClosed is returned when pushing to a closed queue, or popping from one that is closed and drained.
+var ErrQueueClosed = errors.New("types: queue is closed")
+
+// ConcurrentHeap is a priority queue that is safe for use by multiple goroutines.  Pop blocks until an element is
+// available, and when a capacity is set Push blocks until there is room.  Like a channel, it can be closed: pushes
+// then fail, while pops keep draining the remaining elements before reporting ErrQueueClosed.
+type ConcurrentHeap[T any] struct {
+	mu       sync.Mutex
+	heap     *Heap[T]
+	capacity int
+	closed   bool
+	// changed is closed (and replaced) on every push, pop or close, waking up any blocked callers
+	changed chan struct{}
+	// onPark, when set, is called with c.mu held each time a Push or Pop is about to block on changed
+	onPark func()
+}
+
+// NewConcurrentHeap creates a new, empty concurrent min or max heap using the default comparator.
+// A capacity of zero or less means the heap is unbounded.
+func NewConcurrentHeap[T constraints.Ordered](heapType HeapType, capacity int) *ConcurrentHeap[T] {
+	return NewConcurrentHeapWithComparator[T](heapType, capacity, DefaultComparator[T]{})
+}
+
+// NewConcurrentHeapWithComparator creates a new, empty concurrent min or max heap of any type using a custom
+// comparator.  A capacity of zero or less means the heap is unbounded.
+func NewConcurrentHeapWithComparator[T any](heapType HeapType, capacity int, comparator Comparator[T]) *ConcurrentHeap[T] {
+	return &ConcurrentHeap[T]{
+		heap:     NewHeapWithComparator[T](heapType, nil, comparator),
+		capacity: capacity,
+		changed:  make(chan struct{}),
+	}
+}
+
+// Push adds item to the heap, blocking while the heap is at capacity.  It returns ErrQueueClosed if the heap is
+// closed, or the context's error if ctx is done before there is room.
+func (c *ConcurrentHeap[T]) Push(ctx context.Context, item T) error {
+	for {
+		c.mu.Lock()
+		if c.closed {
+			c.mu.Unlock()
+			return ErrQueueClosed
+		}
+		if c.capacity <= 0 || c.heap.Len() < c.capacity {
+			c.heap.Push(item)
+			c.broadcast()
+			c.mu.Unlock()
+			return nil
+		}
+		changed := c.park()
+		c.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Pop removes and returns the root element of the heap, blocking until one is available.  It returns
+// ErrQueueClosed once the heap is closed and empty, or the context's error if ctx is done first.
+func (c *ConcurrentHeap[T]) Pop(ctx context.Context) (T, error) {
+	for {
+		c.mu.Lock()
+		if item, ok := c.heap.Pop(); ok {
+			c.broadcast()
+			c.mu.Unlock()
+			return item, nil
+		}
+		if c.closed {
+			c.mu.Unlock()
+			var zero T
+			return zero, ErrQueueClosed
+		}
+		changed := c.park()
+		c.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryPop removes and returns the root element of the heap without blocking.
+// The boolean result is false if the heap is empty.
+func (c *ConcurrentHeap[T]) TryPop() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.heap.Pop()
+	if ok {
+		c.broadcast()
+	}
+	return item, ok
+}
+
+// Peek returns the root element of the heap without removing it.
+// The boolean result is false if the heap is empty.
+func (c *ConcurrentHeap[T]) Peek() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.heap.Peek()
+}
+
+// Len returns the number of elements in the heap.
+func (c *ConcurrentHeap[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.heap.Len()
+}
+
+// Close marks the heap as closed and wakes up all blocked callers.  Closing an already closed heap has no effect.
+func (c *ConcurrentHeap[T]) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.broadcast()
+}
+
+// park returns the channel a caller about to block should wait on.  Callers must hold c.mu.
+func (c *ConcurrentHeap[T]) park() chan struct{} {
+	if c.onPark != nil {
+		c.onPark()
+	}
+	return c.changed
+}
+
+// broadcast wakes up every goroutine waiting on the current changed channel.  Callers must hold c.mu.
+func (c *ConcurrentHeap[T]) broadcast() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
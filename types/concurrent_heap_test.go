@@ -0,0 +1,218 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConcurrentHeapPopOrder(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 0)
+	for _, item := range []int{5, 2, 9, 1} {
+		if err := heap.Push(context.Background(), item); err != nil {
+			t.Fatalf("Unexpected error pushing %v: %v", item, err)
+		}
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, err := heap.Pop(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error popping: %v", err)
+		}
+		popped = append(popped, item)
+	}
+
+	expected := []int{1, 2, 5, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+// parked returns a channel that receives a value each time a caller of heap is about to block.
+func parked[T any](heap *ConcurrentHeap[T]) <-chan struct{} {
+	parked := make(chan struct{}, 16)
+	heap.onPark = func() { parked <- struct{}{} }
+	return parked
+}
+
+// waitUntilParked waits for a caller of heap to block, failing the test if none does within a second.
+func waitUntilParked(t *testing.T, parked <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-parked:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a caller to block")
+	}
+}
+
+func TestConcurrentHeapPopBlocksUntilPush(t *testing.T) {
+	heap := NewConcurrentHeap[int](MaxHeap, 0)
+	waiting := parked(heap)
+	result := make(chan int)
+	go func() {
+		item, _ := heap.Pop(context.Background())
+		result <- item
+	}()
+
+	// only push once the consumer has found the heap empty and is about to block
+	waitUntilParked(t, waiting)
+	_ = heap.Push(context.Background(), 42)
+
+	select {
+	case item := <-result:
+		if item != 42 {
+			t.Errorf("Expected 42, but got %v", item)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Pop did not return after Push")
+	}
+}
+
+func TestConcurrentHeapPopHonoursContext(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := heap.Pop(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestConcurrentHeapTryPop(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 0)
+	if _, ok := heap.TryPop(); ok {
+		t.Errorf("Expected TryPop on empty heap to return false")
+	}
+
+	_ = heap.Push(context.Background(), 3)
+	if item, ok := heap.TryPop(); !ok || item != 3 {
+		t.Errorf("Expected (3, true), but got (%v, %v)", item, ok)
+	}
+}
+
+func TestConcurrentHeapPushBlocksAtCapacity(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 1)
+	_ = heap.Push(context.Background(), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := heap.Push(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+
+	waiting := parked(heap)
+	done := make(chan error)
+	go func() {
+		done <- heap.Push(context.Background(), 2)
+	}()
+	waitUntilParked(t, waiting)
+	if item, _ := heap.TryPop(); item != 1 {
+		t.Errorf("Expected 1, but got %v", item)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Unexpected error pushing: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Push did not return after Pop made room")
+	}
+	if heap.Len() != 1 {
+		t.Errorf("Expected 1 element, but got %d", heap.Len())
+	}
+}
+
+func TestConcurrentHeapClose(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 0)
+	_ = heap.Push(context.Background(), 7)
+	heap.Close()
+	heap.Close()
+
+	if err := heap.Push(context.Background(), 8); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Expected %v, but got %v", ErrQueueClosed, err)
+	}
+	// remaining elements are still drained after close
+	if item, err := heap.Pop(context.Background()); err != nil || item != 7 {
+		t.Errorf("Expected (7, nil), but got (%v, %v)", item, err)
+	}
+	if _, err := heap.Pop(context.Background()); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Expected %v, but got %v", ErrQueueClosed, err)
+	}
+}
+
+func TestConcurrentHeapCloseWakesBlockedPop(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 0)
+	waiting := parked(heap)
+	done := make(chan error)
+	go func() {
+		_, err := heap.Pop(context.Background())
+		done <- err
+	}()
+	waitUntilParked(t, waiting)
+	heap.Close()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrQueueClosed) {
+			t.Errorf("Expected %v, but got %v", ErrQueueClosed, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Pop did not return after Close")
+	}
+}
+
+func TestConcurrentHeapProducersAndConsumers(t *testing.T) {
+	heap := NewConcurrentHeap[int](MinHeap, 8)
+	const producers, perProducer = 4, 250
+
+	var producing sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producing.Add(1)
+		go func(p int) {
+			defer producing.Done()
+			for i := 0; i < perProducer; i++ {
+				_ = heap.Push(context.Background(), p*perProducer+i)
+			}
+		}(p)
+	}
+
+	var mu sync.Mutex
+	var consumed []int
+	var consuming sync.WaitGroup
+	for c := 0; c < 3; c++ {
+		consuming.Add(1)
+		go func() {
+			defer consuming.Done()
+			for {
+				item, err := heap.Pop(context.Background())
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				consumed = append(consumed, item)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	producing.Wait()
+	heap.Close()
+	consuming.Wait()
+
+	if len(consumed) != producers*perProducer {
+		t.Fatalf("Expected %d elements, but got %d", producers*perProducer, len(consumed))
+	}
+	sort.Ints(consumed)
+	for i, item := range consumed {
+		if item != i {
+			t.Fatalf("Expected %d at position %d, but got %d", i, i, item)
+		}
+	}
+}
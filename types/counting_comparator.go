@@ -0,0 +1,49 @@
+package types
+
+// CountingComparator wraps a comparator and counts the calls made to each of its methods, to measure the work done by
+// an algorithm independently of wall-clock time.  It is not safe for concurrent use.
+type CountingComparator[T any] struct {
+	comparator       Comparator[T]
+	GreaterThanCalls int
+	LessThanCalls    int
+	EqualToCalls     int
+	// CompareCalls counts three-way comparisons, made by callers that use CompareFunc on the counting comparator
+	CompareCalls int
+}
+
+// NewCountingComparator creates a counting comparator that delegates to the given comparator.
+func NewCountingComparator[T any](comparator Comparator[T]) *CountingComparator[T] {
+	return &CountingComparator[T]{comparator: comparator}
+}
+
+func (c *CountingComparator[T]) GreaterThan(a, b T) bool {
+	c.GreaterThanCalls++
+	return c.comparator.GreaterThan(a, b)
+}
+
+func (c *CountingComparator[T]) LessThan(a, b T) bool {
+	c.LessThanCalls++
+	return c.comparator.LessThan(a, b)
+}
+
+func (c *CountingComparator[T]) EqualTo(a, b T) bool {
+	c.EqualToCalls++
+	return c.comparator.EqualTo(a, b)
+}
+
+// Compare counts as a single comparison, even if the wrapped comparator does not implement ThreeWayComparator and
+// has to be called twice.
+func (c *CountingComparator[T]) Compare(a, b T) int {
+	c.CompareCalls++
+	return compare(c.comparator, a, b)
+}
+
+// Total returns the number of comparisons made through any of the methods.
+func (c *CountingComparator[T]) Total() int {
+	return c.GreaterThanCalls + c.LessThanCalls + c.EqualToCalls + c.CompareCalls
+}
+
+// Reset sets all the counts back to zero.
+func (c *CountingComparator[T]) Reset() {
+	c.GreaterThanCalls, c.LessThanCalls, c.EqualToCalls, c.CompareCalls = 0, 0, 0, 0
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCountingComparator(t *testing.T) {
+	counter := NewCountingComparator[int](DefaultComparator[int]{})
+
+	counter.GreaterThan(1, 2)
+	counter.LessThan(1, 2)
+	counter.LessThan(2, 1)
+	counter.EqualTo(1, 1)
+	if result := counter.Compare(3, 2); result != 1 {
+		t.Errorf("Expected 1, but got %d", result)
+	}
+
+	got := [5]int{counter.GreaterThanCalls, counter.LessThanCalls, counter.EqualToCalls, counter.CompareCalls,
+		counter.Total()}
+	expected := [5]int{1, 2, 1, 1, 5}
+	if got != expected {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	counter.Reset()
+	if counter.Total() != 0 {
+		t.Errorf("Expected 0 comparisons after Reset, but got %d", counter.Total())
+	}
+}
+
+func TestCountingComparatorWithCompareFunc(t *testing.T) {
+	// slices.SortFunc only sees a three-way function, so every comparison goes through Compare
+	counter := NewCountingComparator[int](Reverse[int](DefaultComparator[int]{}))
+	data := []int{3, 1, 2}
+
+	slices.SortFunc(data, CompareFunc[int](counter))
+
+	if !slices.Equal(data, []int{3, 2, 1}) {
+		t.Errorf("Expected %v, but got %v", []int{3, 2, 1}, data)
+	}
+	if counter.CompareCalls == 0 || counter.Total() != counter.CompareCalls {
+		t.Errorf("Expected only three-way comparisons, but got %+v", *counter)
+	}
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for a DelayQueue.  Inject a fake implementation to test code using a DelayQueue
+// without real sleeps.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// delayedItem is an item scheduled to become available at a given time.
+type delayedItem[T any] struct {
+	item T
+	at   time.Time
+}
+
+type delayedItemComparator[T any] struct{}
+
+func (d delayedItemComparator[T]) GreaterThan(a, b delayedItem[T]) bool { return a.at.After(b.at) }
+func (d delayedItemComparator[T]) LessThan(a, b delayedItem[T]) bool    { return a.at.Before(b.at) }
+func (d delayedItemComparator[T]) EqualTo(a, b delayedItem[T]) bool     { return a.at.Equal(b.at) }
+
+// DelayQueue is a concurrent queue of items that only become available once their scheduled time has passed, in the
+// order of their scheduled times.
+type DelayQueue[T any] struct {
+	mu     sync.Mutex
+	heap   *IndexedHeap[delayedItem[T]]
+	clock  Clock
+	closed bool
+	// changed is closed (and replaced) whenever the earliest deadline may have changed, waking up any blocked callers
+	changed chan struct{}
+}
+
+// NewDelayQueue creates a new, empty delay queue using the system clock.
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return NewDelayQueueWithClock[T](systemClock{})
+}
+
+// NewDelayQueueWithClock creates a new, empty delay queue using the given clock.
+func NewDelayQueueWithClock[T any](clock Clock) *DelayQueue[T] {
+	return &DelayQueue[T]{
+		heap:    NewIndexedHeapWithComparator[delayedItem[T]](MinHeap, delayedItemComparator[T]{}),
+		clock:   clock,
+		changed: make(chan struct{}),
+	}
+}
+
+// Schedule adds item to the queue, to become available at the given time, and returns a handle that can be passed
+// to Cancel.  It returns ErrQueueClosed if the queue is closed.
+func (q *DelayQueue[T]) Schedule(item T, at time.Time) (Handle, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return -1, ErrQueueClosed
+	}
+	handle := q.heap.Push(delayedItem[T]{item: item, at: at})
+	q.broadcast()
+	return handle, nil
+}
+
+// Cancel removes the item identified by handle from the queue.  It returns false if the item has already been
+// returned by Next or TryNext, or was cancelled before.
+func (q *DelayQueue[T]) Cancel(handle Handle) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	_, ok := q.heap.Remove(handle)
+	if ok {
+		q.broadcast()
+	}
+	return ok
+}
+
+// Next removes and returns the item with the earliest scheduled time, sleeping until that time if it has not passed
+// yet.  It returns the context's error if ctx is done first, and ErrQueueClosed once the queue is closed and no
+// item is ready; items scheduled for later are abandoned on close.
+func (q *DelayQueue[T]) Next(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if item, ok := q.popReady(); ok {
+			q.mu.Unlock()
+			return item, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			var zero T
+			return zero, ErrQueueClosed
+		}
+		var ready <-chan time.Time
+		if next, _, ok := q.heap.Peek(); ok {
+			ready = q.clock.After(next.at.Sub(q.clock.Now()))
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-ready:
+		case <-changed:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryNext removes and returns the item with the earliest scheduled time if that time has passed, without blocking.
+// The boolean result is false if no item is ready.
+func (q *DelayQueue[T]) TryNext() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.popReady()
+}
+
+// Len returns the number of scheduled items, whether they are ready or not.
+func (q *DelayQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.heap.Len()
+}
+
+// Close marks the queue as closed and wakes up all blocked callers.  Closing an already closed queue has no effect.
+func (q *DelayQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.broadcast()
+}
+
+// popReady pops the earliest item if its scheduled time has passed.  Callers must hold q.mu.
+func (q *DelayQueue[T]) popReady() (T, bool) {
+	next, _, ok := q.heap.Peek()
+	if !ok || next.at.After(q.clock.Now()) {
+		var zero T
+		return zero, false
+	}
+	q.heap.Pop()
+	return next.item, true
+}
+
+// broadcast wakes up every goroutine waiting on the current changed channel.  Callers must hold q.mu.
+func (q *DelayQueue[T]) broadcast() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
@@ -0,0 +1,246 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time only moves when Advance is called.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+	// registered receives a value each time After starts a timer
+	registered chan struct{}
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:        time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		registered: make(chan struct{}, 64),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), ch: ch})
+	// a full buffer already holds a signal that waitForTimers has yet to see
+	select {
+	case c.registered <- struct{}{}:
+	default:
+	}
+	return ch
+}
+
+// Advance moves the clock forward by d, firing every timer that has come due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.at.After(c.now) {
+			pending = append(pending, waiter)
+		} else {
+			waiter.ch <- c.now
+		}
+	}
+	c.waiters = pending
+}
+
+// waitForTimers blocks until at least n timers are pending, i.e. until a blocked Next has gone to sleep.
+func (c *fakeClock) waitForTimers(t *testing.T, n int) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		c.mu.Lock()
+		pending := len(c.waiters)
+		c.mu.Unlock()
+		if pending >= n {
+			return
+		}
+		select {
+		case <-c.registered:
+		case <-timeout:
+			t.Fatalf("Timed out waiting for %d pending timers", n)
+		}
+	}
+}
+
+func TestDelayQueueOrdersByScheduledTime(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	_, _ = queue.Schedule("c", clock.Now().Add(3*time.Second))
+	_, _ = queue.Schedule("a", clock.Now().Add(1*time.Second))
+	_, _ = queue.Schedule("b", clock.Now().Add(2*time.Second))
+
+	if _, ok := queue.TryNext(); ok {
+		t.Errorf("Expected no item to be ready before its scheduled time")
+	}
+
+	clock.Advance(5 * time.Second)
+	var items []string
+	for queue.Len() > 0 {
+		item, err := queue.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		items = append(items, item)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+}
+
+func TestDelayQueueNextSleepsUntilDeadline(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	_, _ = queue.Schedule("later", clock.Now().Add(time.Minute))
+
+	result := make(chan string)
+	go func() {
+		item, _ := queue.Next(context.Background())
+		result <- item
+	}()
+
+	clock.waitForTimers(t, 1)
+	clock.Advance(30 * time.Second)
+	select {
+	case item := <-result:
+		t.Fatalf("Expected Next to keep sleeping, but got %v", item)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	clock.Advance(30 * time.Second)
+	select {
+	case item := <-result:
+		if item != "later" {
+			t.Errorf("Expected later, but got %v", item)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Next did not return after the deadline passed")
+	}
+}
+
+func TestDelayQueueEarlierScheduleWakesNext(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	_, _ = queue.Schedule("later", clock.Now().Add(time.Hour))
+
+	result := make(chan string)
+	go func() {
+		item, _ := queue.Next(context.Background())
+		result <- item
+	}()
+
+	clock.waitForTimers(t, 1)
+	_, _ = queue.Schedule("sooner", clock.Now().Add(time.Second))
+	// the sleeping Next re-arms its timer for the new, earlier deadline
+	clock.waitForTimers(t, 2)
+	clock.Advance(time.Second)
+
+	select {
+	case item := <-result:
+		if item != "sooner" {
+			t.Errorf("Expected sooner, but got %v", item)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Next did not return after the earlier deadline passed")
+	}
+}
+
+func TestDelayQueueCancel(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	handle, _ := queue.Schedule("cancelled", clock.Now())
+	_, _ = queue.Schedule("kept", clock.Now())
+
+	if !queue.Cancel(handle) {
+		t.Errorf("Expected Cancel to find the scheduled item")
+	}
+	if queue.Cancel(handle) {
+		t.Errorf("Expected a second Cancel of the same handle to return false")
+	}
+	if item, ok := queue.TryNext(); !ok || item != "kept" {
+		t.Errorf("Expected (kept, true), but got (%v, %v)", item, ok)
+	}
+}
+
+func TestDelayQueueNextHonoursContext(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	_, _ = queue.Schedule("never", clock.Now().Add(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := queue.Next(ctx)
+		done <- err
+	}()
+	clock.waitForTimers(t, 1)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected %v, but got %v", context.Canceled, err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Next did not return after the context was cancelled")
+	}
+}
+
+func TestDelayQueueClose(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	_, _ = queue.Schedule("ready", clock.Now())
+	_, _ = queue.Schedule("pending", clock.Now().Add(time.Hour))
+	queue.Close()
+
+	if _, err := queue.Schedule("rejected", clock.Now()); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Expected %v, but got %v", ErrQueueClosed, err)
+	}
+	if item, err := queue.Next(context.Background()); err != nil || item != "ready" {
+		t.Errorf("Expected (ready, nil), but got (%v, %v)", item, err)
+	}
+	if _, err := queue.Next(context.Background()); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Expected %v, but got %v", ErrQueueClosed, err)
+	}
+}
+
+func TestDelayQueueWithSystemClock(t *testing.T) {
+	queue := NewDelayQueue[int]()
+	start := time.Now()
+	_, _ = queue.Schedule(1, start.Add(20*time.Millisecond))
+
+	item, err := queue.Next(context.Background())
+	if err != nil || item != 1 {
+		t.Errorf("Expected (1, nil), but got (%v, %v)", item, err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Expected Next to wait for the scheduled time, but it returned after %v", elapsed)
+	}
+}
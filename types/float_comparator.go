@@ -0,0 +1,77 @@
+package types
+
+import (
+	"math"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+// FloatComparator orders floating point numbers totally, following IEEE 754 totalOrder except that every NaN sorts
+// first regardless of its sign or payload: NaNs are equal to each other and less than any other value, and -0 is
+// less than +0.  Unlike DefaultComparator, which considers NaN neither less than, greater than nor equal to anything,
+// it is safe to use for sorting slices that may contain NaNs.
+type FloatComparator[T constraints.Float] struct{}
+
+func (f FloatComparator[T]) GreaterThan(a, b T) bool { return f.Compare(a, b) > 0 }
+func (f FloatComparator[T]) LessThan(a, b T) bool    { return f.Compare(a, b) < 0 }
+func (f FloatComparator[T]) EqualTo(a, b T) bool     { return f.Compare(a, b) == 0 }
+func (f FloatComparator[T]) Compare(a, b T) int {
+	return totalOrder(a, b, func(v T) bool { return math.Signbit(float64(v)) })
+}
+
+// OrderedComparator returns the comparator that sorting functions use for ordered types: a FloatComparator for
+// floating point types, including named ones, and DefaultComparator for everything else.
+func OrderedComparator[T constraints.Ordered]() Comparator[T] {
+	var zero T
+	switch any(zero).(type) {
+	case float64:
+		return any(FloatComparator[float64]{}).(Comparator[T])
+	case float32:
+		return any(FloatComparator[float32]{}).(Comparator[T])
+	}
+	if kind := reflect.TypeOf(zero).Kind(); kind == reflect.Float32 || kind == reflect.Float64 {
+		return namedFloatComparator[T]{}
+	}
+	return DefaultComparator[T]{}
+}
+
+// namedFloatComparator is FloatComparator for named floating point types, which OrderedComparator cannot instantiate
+// FloatComparator with because it only knows they are ordered.
+type namedFloatComparator[T constraints.Ordered] struct{}
+
+func (n namedFloatComparator[T]) GreaterThan(a, b T) bool { return n.Compare(a, b) > 0 }
+func (n namedFloatComparator[T]) LessThan(a, b T) bool    { return n.Compare(a, b) < 0 }
+func (n namedFloatComparator[T]) EqualTo(a, b T) bool     { return n.Compare(a, b) == 0 }
+func (n namedFloatComparator[T]) Compare(a, b T) int {
+	return totalOrder(a, b, func(v T) bool { return math.Signbit(reflect.ValueOf(v).Float()) })
+}
+
+// totalOrder compares two floating point numbers, using signbit to tell -0 and +0 apart.
+func totalOrder[T constraints.Ordered](a, b T, signbit func(T) bool) int {
+	// NaN is the only value that is not equal to itself
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN || bNaN:
+		return boolCompare(bNaN, aNaN)
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		// a and b are equal, but may still be zeros of opposite signs
+		return boolCompare(signbit(b), signbit(a))
+	}
+}
+
+// boolCompare orders false before true.
+func boolCompare(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case b:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type celsius float64
+
+func TestFloatComparator(t *testing.T) {
+	comparator := FloatComparator[float64]{}
+	nan, negativeZero := math.NaN(), math.Copysign(0, -1)
+
+	cases := []struct {
+		a, b     float64
+		expected int
+	}{
+		{1, 2, -1},
+		{2, 1, 1},
+		{1, 1, 0},
+		{nan, nan, 0},
+		{nan, math.Inf(-1), -1},
+		{math.Inf(1), nan, 1},
+		{-math.Copysign(nan, -1), nan, 0},
+		{negativeZero, 0, -1},
+		{0, negativeZero, 1},
+		{negativeZero, negativeZero, 0},
+		{math.Inf(-1), -math.MaxFloat64, -1},
+	}
+	for _, tc := range cases {
+		if got := comparator.Compare(tc.a, tc.b); got != tc.expected {
+			t.Errorf("Expected Compare(%v, %v) to be %d, but got %d", tc.a, tc.b, tc.expected, got)
+		}
+	}
+	if comparator.EqualTo(negativeZero, 0) || !comparator.LessThan(nan, 0) || !comparator.GreaterThan(0, negativeZero) {
+		t.Errorf("Expected the boolean methods to agree with Compare")
+	}
+}
+
+func TestOrderedComparator(t *testing.T) {
+	if _, ok := OrderedComparator[float64]().(FloatComparator[float64]); !ok {
+		t.Errorf("Expected a FloatComparator for float64")
+	}
+	if _, ok := OrderedComparator[float32]().(FloatComparator[float32]); !ok {
+		t.Errorf("Expected a FloatComparator for float32")
+	}
+	if _, ok := OrderedComparator[string]().(DefaultComparator[string]); !ok {
+		t.Errorf("Expected a DefaultComparator for string")
+	}
+
+	comparator := OrderedComparator[celsius]()
+	nan, negativeZero := celsius(math.NaN()), celsius(math.Copysign(0, -1))
+	if !comparator.LessThan(nan, -273.15) || !comparator.LessThan(negativeZero, 0) || !comparator.EqualTo(nan, nan) {
+		t.Errorf("Expected a total order for named float types")
+	}
+
+	data := []celsius{3, nan, 0, -1, negativeZero}
+	got := sortWith(data, comparator)
+	expected := []uint64{
+		math.Float64bits(float64(nan)), math.Float64bits(-1), math.Float64bits(float64(negativeZero)),
+		math.Float64bits(0), math.Float64bits(3),
+	}
+	var gotBits []uint64
+	for _, v := range got {
+		gotBits = append(gotBits, math.Float64bits(float64(v)))
+	}
+	if !reflect.DeepEqual(gotBits, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
 	"golang.org/x/exp/constraints"
 )
 
@@ -11,31 +14,86 @@ const (
 	MaxHeap
 )
 
-type Heap[T constraints.Ordered] struct {
-	Data    []T
-	compare func(a, b T) bool
+func (t HeapType) String() string {
+	switch t {
+	case MinHeap:
+		return "MinHeap"
+	case MaxHeap:
+		return "MaxHeap"
+	default:
+		return fmt.Sprintf("HeapType(%d)", int(t))
+	}
 }
 
-// NewHeap creates a new min-heap from the provided elements.
+var (
+	// ErrHeapTypeMismatch is returned when melding a min heap with a max heap.
+	ErrHeapTypeMismatch = errors.New("types: cannot meld heaps of different heap types")
+	// ErrInvalidKey is returned by DecreaseKey when the new value would move the element away from the root.
+	ErrInvalidKey = errors.New("types: new key ranks after the current key")
+	// ErrNodeNotInHeap is returned when a node handle refers to an element that has already been popped, or that
+	// belongs to another heap.
+	ErrNodeNotInHeap = errors.New("types: node is not in the heap")
+)
+
+type Heap[T any] struct {
+	Data []T
+	// arity is the maximum number of children per node, 2 for a binary heap
+	arity      int
+	heapType   HeapType
+	comparator Comparator[T]
+	compare    func(a, b T) bool
+	// onSwap, when set, is notified after the elements at indices i and j trade places
+	onSwap func(i, j int)
+}
+
+// NewHeap creates a new min or max heap from the provided elements using the default comparator.
 func NewHeap[T constraints.Ordered](heapType HeapType, elements []T) *Heap[T] {
-	comparator := &DefaultComparator[T]{}
-	var comparison func(a, b T) bool
-	switch heapType {
-	case MinHeap:
-		comparison = comparator.LessThan
-	case MaxHeap:
-		comparison = comparator.GreaterThan
+	return NewHeapWithComparator[T](heapType, elements, DefaultComparator[T]{})
+}
+
+// NewHeapWithComparator creates a new min or max heap from the provided elements of any type.
+// The comparator defines the ordering of elements; a MinHeap keeps the element that is
+// LessThan all others at the root, a MaxHeap the element that is GreaterThan all others.
+func NewHeapWithComparator[T any](heapType HeapType, elements []T, comparator Comparator[T]) *Heap[T] {
+	return NewDaryHeapWithComparator[T](2, heapType, elements, comparator)
+}
+
+// NewDaryHeap creates a new min or max d-ary heap, where every node has up to arity children, from the provided
+// elements using the default comparator.  Wider heaps are shallower, trading more comparisons per bubbleDown for
+// fewer levels and better cache locality on insert-heavy workloads.  It panics if arity is less than 2.
+func NewDaryHeap[T constraints.Ordered](arity int, heapType HeapType, elements []T) *Heap[T] {
+	return NewDaryHeapWithComparator[T](arity, heapType, elements, DefaultComparator[T]{})
+}
+
+// NewDaryHeapWithComparator creates a new min or max d-ary heap from the provided elements of any type using a
+// custom comparator.  It panics if arity is less than 2.
+func NewDaryHeapWithComparator[T any](arity int, heapType HeapType, elements []T, comparator Comparator[T]) *Heap[T] {
+	if arity < 2 {
+		panic("types: heap arity must be at least 2")
 	}
-	heap := &Heap[T]{Data: elements, compare: comparison}
+	heap := &Heap[T]{Data: elements, arity: arity, heapType: heapType, comparator: comparator}
+	heap.compare = comparison(heapType, comparator)
 	heap.Heapify()
 
 	return heap
 }
 
+// comparison returns the comparator function that decides whether a ranks before b in a heap of the given type.
+func comparison[T any](heapType HeapType, comparator Comparator[T]) func(a, b T) bool {
+	switch heapType {
+	case MinHeap:
+		return comparator.LessThan
+	case MaxHeap:
+		return comparator.GreaterThan
+	}
+	return nil
+}
+
 // Insert adds a new item to the heap and maintains the heap property.
 func (h *Heap[T]) Insert(item T) {
 	h.Data = append(h.Data, item)
 	h.bubbleUp(h.size() - 1)
+	h.checkInvariant()
 }
 
 // Delete removes the element at index i from the heap and maintains the heap property.
@@ -44,28 +102,93 @@ func (h *Heap[T]) Delete(i int) {
 	if i > h.size()-1 || i < 0 {
 		return
 	}
-	// edge case: handle deleting from a single-element heap
-	if h.size() == 1 && i == 0 {
-		h.Data = []T{}
-		return
-	}
-	// the element to delete is already at the end, simply resize
-	if i == h.size()-1 {
-		h.Data = h.Data[:h.size()-1]
-		return
-	} else {
-		// otherwise move the element to delete to the end, resize and bubbleDown
-		h.Data[i], h.Data[h.size()-1] = h.Data[h.size()-1], h.Data[i]
-		h.Data = h.Data[:h.size()-1]
+	last := h.size() - 1
+	if i < last {
+		// move the element to delete to the end, resize and bubbleDown.  the element moved into index i came from a
+		// different subtree, so it may also need to move up
+		h.swap(i, last)
+		h.Data = h.Data[:last]
 		h.bubbleDown(i)
+		h.bubbleUp(i)
+	} else {
+		// the element to delete is already at the end, simply resize
+		h.Data = h.Data[:last]
+	}
+	h.checkInvariant()
+}
+
+// Push adds a new item to the heap and maintains the heap property.
+func (h *Heap[T]) Push(item T) {
+	h.Insert(item)
+}
+
+// Pop removes and returns the root element of the heap (the minimum of a MinHeap, the maximum of a MaxHeap).
+// The boolean result is false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if h.size() == 0 {
+		var zero T
+		return zero, false
 	}
+	root := h.Data[0]
+	h.Delete(0)
+	return root, true
+}
+
+// Peek returns the root element of the heap without removing it.
+// The boolean result is false if the heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.size() == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Data[0], true
+}
+
+// Replace pops the root element and pushes item in a single sift, returning the popped element.
+// The returned element may rank after item.  On an empty heap item is simply pushed and false is returned.
+func (h *Heap[T]) Replace(item T) (T, bool) {
+	if h.size() == 0 {
+		h.Push(item)
+		var zero T
+		return zero, false
+	}
+	root := h.Data[0]
+	h.Data[0] = item
+	h.bubbleDown(0)
+	h.checkInvariant()
+	return root, true
+}
+
+// PushPop pushes item and then pops the root element in a single sift, returning the popped element.
+// When item would become the new root, it is returned immediately and the heap is left untouched.
+func (h *Heap[T]) PushPop(item T) T {
+	if h.size() == 0 || !h.compare(h.Data[0], item) {
+		return item
+	}
+	root := h.Data[0]
+	h.Data[0] = item
+	h.bubbleDown(0)
+	h.checkInvariant()
+	return root
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return h.size()
+}
+
+// Clear removes all elements from the heap, retaining the underlying storage.
+func (h *Heap[T]) Clear() {
+	h.Data = h.Data[:0]
+	h.checkInvariant()
 }
 
 // Heapify builds the heap from the initial Data slice.
 func (h *Heap[T]) Heapify() {
-	for i := h.size() / 2; i >= 0; i-- {
+	for i := h.parent(h.size() - 1); i >= 0; i-- {
 		h.bubbleDown(i)
 	}
+	h.checkInvariant()
 }
 
 // HeapifyToIndex builds the heap from the initial Data slice, considering elements up to maxIdx.
@@ -75,66 +198,58 @@ func (h *Heap[T]) HeapifyToIndex(maxIdx int) {
 	}
 }
 
+// fix restores the heap property after the element at index i has changed, moving it down or up as needed.
+func (h *Heap[T]) fix(i int) {
+	h.bubbleDown(i)
+	h.bubbleUp(i)
+	h.checkInvariant()
+}
+
+// swap exchanges the elements at indices i and j.
+func (h *Heap[T]) swap(i, j int) {
+	h.Data[i], h.Data[j] = h.Data[j], h.Data[i]
+	if h.onSwap != nil {
+		h.onSwap(i, j)
+	}
+}
+
 // bubbleUp restores the heap property by moving the element at index i up.
 func (h *Heap[T]) bubbleUp(i int) {
 	if i < 1 {
 		return
 	}
-	if h.compare(h.Data[i], h.Data[i/2]) || h.Data[i] == h.Data[i/2] {
-		h.Data[i], h.Data[i/2] = h.Data[i/2], h.Data[i]
-		h.bubbleUp(i / 2)
+	if h.compare(h.Data[i], h.Data[h.parent(i)]) {
+		h.swap(i, h.parent(i))
+		h.bubbleUp(h.parent(i))
 	}
 }
 
 // bubbleDown restores the heap property by moving the element at index i down.
 func (h *Heap[T]) bubbleDown(i int) {
-	smallest := i
-
-	if left(i) < h.size() && h.compare(h.Data[left(i)], h.Data[smallest]) {
-		smallest = left(i)
-	}
-
-	if right(i) < h.size() && h.compare(h.Data[right(i)], h.Data[smallest]) {
-		smallest = right(i)
-	}
-
-	if smallest != i {
-		h.Data[i], h.Data[smallest] = h.Data[smallest], h.Data[i]
-		h.bubbleDown(smallest)
-	}
+	h.bubbleDownToIndex(i, h.size())
 }
 
 // bubbleDownToIndex restores the heap property by moving the element at index i down, considering elements up to maxIdx.
 func (h *Heap[T]) bubbleDownToIndex(i int, maxIdx int) {
 	smallest := i
 
-	if left(i) < maxIdx && h.compare(h.Data[left(i)], h.Data[smallest]) {
-		smallest = left(i)
-	}
-
-	if right(i) < maxIdx && h.compare(h.Data[right(i)], h.Data[smallest]) {
-		smallest = right(i)
+	first := h.firstChild(i)
+	for child := first; child < first+h.arity && child < maxIdx; child++ {
+		if h.compare(h.Data[child], h.Data[smallest]) {
+			smallest = child
+		}
 	}
 
 	if smallest != i {
-		h.Data[i], h.Data[smallest] = h.Data[smallest], h.Data[i]
+		h.swap(i, smallest)
 		h.bubbleDownToIndex(smallest, maxIdx)
 	}
 }
 
-// isValidHeap checks if the heap property is maintained for the entire heap.
+// isValidHeap checks if the heap property is maintained for the entire heap.  Use Validate to find out where the
+// heap property is violated.
 func (h *Heap[T]) IsValidHeap() bool {
-	for i := 0; i < h.size(); i++ {
-		leftIdx := left(i)
-		rightIdx := right(i)
-		if leftIdx < h.size() && h.compare(h.Data[leftIdx], h.Data[i]) {
-			return false
-		}
-		if rightIdx < h.size() && h.compare(h.Data[rightIdx], h.Data[i]) {
-			return false
-		}
-	}
-	return true
+	return len(h.violations(1)) == 0
 }
 
 // returns the number of elements in the heap.
@@ -142,12 +257,13 @@ func (h *Heap[T]) size() int {
 	return len(h.Data)
 }
 
-// returns left child for a given index, converting from 0 to 1 based indexing
-func left(i int) int {
-	return 2*i + 1
+// returns the parent for a given index, converting from 0 to 1 based indexing
+func (h *Heap[T]) parent(i int) int {
+	return (i - 1) / h.arity
 }
 
-// returns right child for a given index, converting from 0 to 1 based indexing
-func right(i int) int {
-	return left(i) + 1
+// returns the first (left-most) child for a given index, converting from 0 to 1 based indexing.
+// the remaining children follow it contiguously, up to arity children in total
+func (h *Heap[T]) firstChild(i int) int {
+	return h.arity*i + 1
 }
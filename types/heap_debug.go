@@ -0,0 +1,7 @@
+//go:build heapdebug
+
+package types
+
+// heapDebug enables a full invariant check after every heap mutation, turning silent corruption (for example from
+// mutating Data directly) into a panic at the first operation that observes it.  Every mutation becomes O(n).
+const heapDebug = true
@@ -0,0 +1,66 @@
+//go:build heapdebug
+
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHeapDebugPanicsOnCorruptHeap(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{1, 2, 3, 4, 5})
+	heap.Data[0] = 10
+
+	defer func() {
+		err, _ := recover().(error)
+		var invariantErr *HeapInvariantError[int]
+		if !errors.As(err, &invariantErr) {
+			t.Errorf("Expected a panic with a *HeapInvariantError, but got %v", err)
+		}
+	}()
+	heap.Insert(6)
+}
+
+func TestHeapDebugChecksEveryMutation(t *testing.T) {
+	// each mutation leaves the corrupted root alone, so only the invariant check can notice it
+	mutations := []struct {
+		name   string
+		mutate func(heap *Heap[int])
+	}{
+		{"Delete last index", func(heap *Heap[int]) { heap.Delete(heap.Len() - 1) }},
+		{"fix", func(heap *Heap[int]) { heap.fix(heap.Len() - 1) }},
+	}
+	for _, m := range mutations {
+		t.Run(m.name, func(t *testing.T) {
+			heap := NewHeap[int](MinHeap, []int{1, 2, 3, 4, 5})
+			heap.Data[0] = 10
+
+			defer func() {
+				err, _ := recover().(error)
+				var invariantErr *HeapInvariantError[int]
+				if !errors.As(err, &invariantErr) {
+					t.Errorf("Expected a panic with a *HeapInvariantError, but got %v", err)
+				}
+			}()
+			m.mutate(heap)
+		})
+	}
+}
+
+func TestHeapDebugChecksIndexedHeapUpdate(t *testing.T) {
+	heap := NewIndexedHeap[int](MinHeap)
+	for _, item := range []int{1, 2, 3, 4, 5} {
+		heap.Push(item)
+	}
+	last := heap.Push(6)
+	heap.heap.Data[0].value = 10
+
+	defer func() {
+		err, _ := recover().(error)
+		var invariantErr *HeapInvariantError[*indexedEntry[int]]
+		if !errors.As(err, &invariantErr) {
+			t.Errorf("Expected a panic with a *HeapInvariantError, but got %v", err)
+		}
+	}()
+	heap.Update(last, 7)
+}
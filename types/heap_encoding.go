@@ -0,0 +1,117 @@
+package types
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSnapshot is returned, wrapped with details, when unmarshalling a heap from corrupt or incompatible data.
+var ErrInvalidSnapshot = errors.New("types: invalid heap snapshot")
+
+// heapBinaryVersion is written as the first byte of every binary snapshot so the format can evolve.
+const heapBinaryVersion byte = 1
+
+// heapSnapshot is the serialised form of a Heap.  The comparator cannot be serialised, so a heap must be constructed
+// with the matching comparator before unmarshalling into it.
+//
+// Only Heap, whose Data slice is its whole state, implements the marshalling interfaces.  IndexedHeap, PairingHeap
+// and BinomialHeap hand out handles that a snapshot could not restore, and the remaining heap types do not support
+// serialisation yet.
+type heapSnapshot[T any] struct {
+	HeapType HeapType `json:"heapType"`
+	Arity    int      `json:"arity"`
+	Data     []T      `json:"data"`
+}
+
+// MarshalText encodes the heap type as "MinHeap" or "MaxHeap".
+func (t HeapType) MarshalText() ([]byte, error) {
+	if t != MinHeap && t != MaxHeap {
+		return nil, fmt.Errorf("types: unknown heap type %d", int(t))
+	}
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes a heap type encoded by MarshalText.
+func (t *HeapType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case MinHeap.String():
+		*t = MinHeap
+	case MaxHeap.String():
+		*t = MaxHeap
+	default:
+		return fmt.Errorf("types: unknown heap type %q", text)
+	}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the heap type, arity and elements using encoding/gob.
+func (h *Heap[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(heapBinaryVersion)
+	if err := gob.NewEncoder(&buf).Encode(h.snapshot()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.  The heap must already have a comparator, i.e. be created
+// with NewHeap or NewHeapWithComparator, and the snapshot is rejected with an error wrapping ErrInvalidSnapshot if it
+// cannot be decoded or its elements do not satisfy the heap property under that comparator.  On error the heap is
+// left unchanged.
+func (h *Heap[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != heapBinaryVersion {
+		return fmt.Errorf("%w: unsupported binary format", ErrInvalidSnapshot)
+	}
+	var snapshot heapSnapshot[T]
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&snapshot); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	return h.restore(snapshot)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the heap as {"heapType": ..., "arity": ..., "data": [...]}.
+func (h *Heap[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.snapshot())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, with the same requirements and validation as UnmarshalBinary.
+func (h *Heap[T]) UnmarshalJSON(data []byte) error {
+	var snapshot heapSnapshot[T]
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	return h.restore(snapshot)
+}
+
+func (h *Heap[T]) snapshot() heapSnapshot[T] {
+	return heapSnapshot[T]{HeapType: h.heapType, Arity: h.arity, Data: h.Data}
+}
+
+// restore replaces the heap's configuration and elements with those of snapshot, after checking that the snapshot
+// describes a valid heap under the heap's comparator.
+func (h *Heap[T]) restore(snapshot heapSnapshot[T]) error {
+	if h.comparator == nil {
+		return fmt.Errorf("%w: cannot unmarshal into a heap without a comparator, create it with NewHeap or NewHeapWithComparator first", ErrInvalidSnapshot)
+	}
+	if snapshot.HeapType != MinHeap && snapshot.HeapType != MaxHeap {
+		return fmt.Errorf("%w: unknown heap type %d", ErrInvalidSnapshot, int(snapshot.HeapType))
+	}
+	if snapshot.Arity < 2 {
+		return fmt.Errorf("%w: arity %d is less than 2", ErrInvalidSnapshot, snapshot.Arity)
+	}
+	if snapshot.Data == nil {
+		snapshot.Data = []T{}
+	}
+
+	restored := *h
+	restored.Data, restored.arity, restored.heapType = snapshot.Data, snapshot.Arity, snapshot.HeapType
+	restored.compare = comparison(snapshot.HeapType, h.comparator)
+	if err := restored.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	*h = restored
+	return nil
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHeapBinaryRoundTrip(t *testing.T) {
+	heap := NewDaryHeap[int](4, MaxHeap, []int{5, 2, 9, 1, 5, 6})
+	data, err := heap.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error marshalling: %v", err)
+	}
+
+	restored := NewHeap[int](MinHeap, []int{})
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error unmarshalling: %v", err)
+	}
+	if restored.heapType != MaxHeap || restored.arity != 4 || !reflect.DeepEqual(restored.Data, heap.Data) {
+		t.Errorf("Expected %v %d-ary heap %v, but got %v %d-ary heap %v",
+			heap.heapType, heap.arity, heap.Data, restored.heapType, restored.arity, restored.Data)
+	}
+
+	// the restored heap uses the snapshot's heap type for subsequent operations
+	restored.Push(10)
+	if item, _ := restored.Pop(); item != 10 {
+		t.Errorf("Expected 10, but got %v", item)
+	}
+}
+
+func TestHeapBinaryRoundTripWithComparator(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	heap := NewHeapWithComparator[Person](MinHeap, []Person{charlie, alice, bob}, PersonComparator{})
+	data, err := heap.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error marshalling: %v", err)
+	}
+
+	restored := NewHeapWithComparator[Person](MinHeap, nil, PersonComparator{})
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error unmarshalling: %v", err)
+	}
+	if item, _ := restored.Peek(); !item.Dob.Equal(bob.Dob) || item.Name != bob.Name {
+		t.Errorf("Expected %v, but got %v", bob, item)
+	}
+}
+
+func TestHeapJSONRoundTrip(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 2, 9, 1})
+	data, err := json.Marshal(heap)
+	if err != nil {
+		t.Fatalf("Unexpected error marshalling: %v", err)
+	}
+
+	expected := `{"heapType":"MinHeap","arity":2,"data":[1,2,9,5]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, but got %s", expected, data)
+	}
+
+	restored := NewHeap[int](MaxHeap, []int{})
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("Unexpected error unmarshalling: %v", err)
+	}
+	if restored.heapType != MinHeap || !reflect.DeepEqual(restored.Data, heap.Data) {
+		t.Errorf("Expected %v heap %v, but got %v heap %v", heap.heapType, heap.Data, restored.heapType, restored.Data)
+	}
+}
+
+func TestHeapUnmarshalRejectsCorruptSnapshots(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"heap property violated", `{"heapType":"MinHeap","arity":2,"data":[5,1,9]}`},
+		{"unknown heap type", `{"heapType":"MiddleHeap","arity":2,"data":[1]}`},
+		{"invalid arity", `{"heapType":"MinHeap","arity":1,"data":[1]}`},
+		{"wrong element type", `{"heapType":"MinHeap","arity":2,"data":["a"]}`},
+		{"malformed", `{"heapType":`},
+	}
+
+	for _, tt := range tests {
+		heap := NewHeap[int](MaxHeap, []int{3, 2})
+		err := heap.UnmarshalJSON([]byte(tt.data))
+		if !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("%s: Expected %v, but got %v", tt.name, ErrInvalidSnapshot, err)
+		}
+		if heap.heapType != MaxHeap || !reflect.DeepEqual(heap.Data, []int{3, 2}) {
+			t.Errorf("%s: Expected the heap to be left unchanged, but got %v heap %v", tt.name, heap.heapType, heap.Data)
+		}
+	}
+}
+
+func TestHeapUnmarshalReportsViolation(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{})
+	err := json.Unmarshal([]byte(`{"heapType":"MinHeap","arity":2,"data":[5,1,9]}`), heap)
+
+	var invariantErr *HeapInvariantError[int]
+	if !errors.As(err, &invariantErr) {
+		t.Fatalf("Expected a *HeapInvariantError, but got %v", err)
+	}
+
+	expected := []HeapViolation[int]{{Parent: 0, Child: 1, ParentValue: 5, ChildValue: 1}}
+	if !reflect.DeepEqual(invariantErr.Violations, expected) {
+		t.Errorf("Expected violations %v, but got %v", expected, invariantErr.Violations)
+	}
+}
+
+func TestHeapUnmarshalBinaryRejectsCorruptSnapshots(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{1, 2, 3})
+	data, _ := heap.MarshalBinary()
+
+	for _, corrupt := range [][]byte{nil, {0}, append([]byte{heapBinaryVersion + 1}, data[1:]...), data[:len(data)/2]} {
+		restored := NewHeap[int](MinHeap, []int{})
+		if err := restored.UnmarshalBinary(corrupt); !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("Expected %v for %v, but got %v", ErrInvalidSnapshot, corrupt, err)
+		}
+	}
+}
+
+func TestHeapUnmarshalWithoutComparator(t *testing.T) {
+	var heap Heap[int]
+	if err := json.Unmarshal([]byte(`{"heapType":"MinHeap","arity":2,"data":[1]}`), &heap); !errors.Is(err, ErrInvalidSnapshot) {
+		t.Errorf("Expected %v unmarshalling into a heap without a comparator, but got %v", ErrInvalidSnapshot, err)
+	}
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"container/heap"
+)
+
+// HeapAdapter adapts a binary Heap to the standard library's container/heap.Interface, so it can be manipulated with
+// heap.Push, heap.Pop, heap.Fix and heap.Remove.  Both sides share the same array layout, so the Heap remains valid
+// and can keep being used directly in between.
+type HeapAdapter[T any] struct {
+	heap *Heap[T]
+}
+
+// AsHeapInterface wraps h in a HeapAdapter.  It panics if h is a d-ary heap with an arity other than 2, since
+// container/heap only maintains binary heaps.
+func AsHeapInterface[T any](h *Heap[T]) *HeapAdapter[T] {
+	if h.arity != 2 {
+		panic("types: container/heap can only drive binary heaps")
+	}
+	return &HeapAdapter[T]{heap: h}
+}
+
+// Len returns the number of elements in the heap.
+func (a *HeapAdapter[T]) Len() int { return a.heap.Len() }
+
+// Less reports whether the element at index i ranks before the element at index j.
+func (a *HeapAdapter[T]) Less(i, j int) bool { return a.heap.compare(a.heap.Data[i], a.heap.Data[j]) }
+
+// Swap exchanges the elements at indices i and j.
+func (a *HeapAdapter[T]) Swap(i, j int) { a.heap.swap(i, j) }
+
+// Push appends x, which must be of type T, to the end of the heap.  Call heap.Push rather than this method directly.
+func (a *HeapAdapter[T]) Push(x any) {
+	a.heap.Data = append(a.heap.Data, x.(T))
+}
+
+// Pop removes and returns the last element of the heap.  Call heap.Pop rather than this method directly.
+func (a *HeapAdapter[T]) Pop() any {
+	last := a.heap.size() - 1
+	item := a.heap.Data[last]
+	a.heap.Data = a.heap.Data[:last]
+	return item
+}
+
+// InterfaceHeap gives any container/heap.Interface implementation the typed Push, Pop, Peek and Len methods of Heap.
+// Created with NewInterfaceHeap it orders elements by the wrapped type's own Less method; created with
+// NewInterfaceHeapWithComparator it orders them by a Comparator, like Heap.  Either way T must be the type the
+// wrapped Push and Pop methods accept and return.
+type InterfaceHeap[T any] struct {
+	heap heap.Interface
+	// at returns the element at index i of the wrapped heap, or is nil if it was not provided
+	at func(i int) T
+}
+
+// NewInterfaceHeap wraps h, whose Push and Pop methods must accept and return values of type T, and establishes the
+// heap property with heap.Init.
+func NewInterfaceHeap[T any](h heap.Interface) *InterfaceHeap[T] {
+	heap.Init(h)
+	return &InterfaceHeap[T]{heap: h}
+}
+
+// NewInterfaceHeapWithComparator wraps h like NewInterfaceHeap, but orders its elements as a min or max heap using
+// comparator instead of h's Less method, which is never called.  at must return the element at index i of h, which
+// for the usual slice-based implementation is simply the i-th element of the slice.
+func NewInterfaceHeapWithComparator[T any](h heap.Interface, heapType HeapType, at func(i int) T, comparator Comparator[T]) *InterfaceHeap[T] {
+	ordered := comparatorInterface[T]{Interface: h, at: at, compare: comparison(heapType, comparator)}
+	heap.Init(ordered)
+	return &InterfaceHeap[T]{heap: ordered, at: at}
+}
+
+// comparatorInterface overrides the Less method of a heap.Interface with a comparison of its elements.
+type comparatorInterface[T any] struct {
+	heap.Interface
+	at      func(i int) T
+	compare func(a, b T) bool
+}
+
+func (c comparatorInterface[T]) Less(i, j int) bool { return c.compare(c.at(i), c.at(j)) }
+
+// Push adds a new item to the heap and maintains the heap property.
+func (h *InterfaceHeap[T]) Push(item T) {
+	heap.Push(h.heap, item)
+}
+
+// Pop removes and returns the element the heap ranks first.  The boolean result is false if the heap is empty, or if
+// the element is not of type T, in which case it is still removed.
+func (h *InterfaceHeap[T]) Pop() (T, bool) {
+	if h.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	item, ok := heap.Pop(h.heap).(T)
+	return item, ok
+}
+
+// Peek returns the element the heap ranks first.  The boolean result is false if the heap is empty or the element is
+// not of type T.
+//
+// For a heap created with NewInterfaceHeapWithComparator the root is read in place.  heap.Interface itself offers no
+// way to read an element, so for a heap created with NewInterfaceHeap Peek is not read-only: it pops the root and
+// pushes it back, which takes O(log n), calls the wrapped Pop and Push methods and may rearrange the wrapped
+// container.
+func (h *InterfaceHeap[T]) Peek() (T, bool) {
+	if h.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	if h.at != nil {
+		return h.at(0), true
+	}
+	root := heap.Pop(h.heap)
+	heap.Push(h.heap, root)
+	item, ok := root.(T)
+	return item, ok
+}
+
+// Len returns the number of elements in the heap.
+func (h *InterfaceHeap[T]) Len() int {
+	return h.heap.Len()
+}
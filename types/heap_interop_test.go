@@ -0,0 +1,180 @@
+package types
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// intHeap is the min heap of ints from the container/heap documentation.
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
+func TestHeapAdapterWithContainerHeap(t *testing.T) {
+	items := []int{5, 2, 9, 1, 5, 6, 3}
+	adapted := NewHeap[int](MinHeap, []int{})
+	adapter := AsHeapInterface(adapted)
+	std := &intHeap{}
+	for _, item := range items {
+		heap.Push(adapter, item)
+		heap.Push(std, item)
+		if !adapted.IsValidHeap() {
+			t.Errorf("Heap property violated after pushing %v: %v", item, adapted.Data)
+		}
+	}
+
+	var adaptedOrder, stdOrder []int
+	for adapter.Len() > 0 {
+		adaptedOrder = append(adaptedOrder, heap.Pop(adapter).(int))
+		stdOrder = append(stdOrder, heap.Pop(std).(int))
+	}
+	if !reflect.DeepEqual(adaptedOrder, stdOrder) {
+		t.Errorf("Expected pop order %v, but got %v", stdOrder, adaptedOrder)
+	}
+}
+
+func TestHeapAdapterInterleavedWithHeap(t *testing.T) {
+	h := NewHeap[int](MaxHeap, []int{5, 2, 9})
+	adapter := AsHeapInterface(h)
+
+	heap.Push(adapter, 7)
+	h.Push(8)
+	// demote the root, 9, and let container/heap restore the heap property
+	h.Data[0] = 1
+	heap.Fix(adapter, 0)
+	if !h.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", h.Data)
+	}
+
+	var popped []int
+	for h.Len() > 0 {
+		item, _ := h.Pop()
+		popped = append(popped, item)
+	}
+
+	expected := []int{8, 7, 5, 2, 1}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestHeapAdapterRejectsDaryHeap(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected AsHeapInterface to panic for a 4-ary heap")
+		}
+	}()
+	AsHeapInterface(NewDaryHeap[int](4, MinHeap, []int{}))
+}
+
+func TestInterfaceHeapMatchesHeap(t *testing.T) {
+	items := []int{5, 2, 9, 1, 5, 6, 3}
+	std := intHeap{4, 8}
+	wrapped := NewInterfaceHeap[int](&std)
+	typed := NewHeap[int](MinHeap, []int{4, 8})
+	for _, item := range items {
+		wrapped.Push(item)
+		typed.Push(item)
+	}
+
+	var wrappedOrder, typedOrder []int
+	for wrapped.Len() > 0 {
+		expected, _ := typed.Peek()
+		if peeked, _ := wrapped.Peek(); peeked != expected {
+			t.Errorf("Expected Peek to return %v, but got %v", expected, peeked)
+		}
+		item, _ := wrapped.Pop()
+		wrappedOrder = append(wrappedOrder, item)
+		item, _ = typed.Pop()
+		typedOrder = append(typedOrder, item)
+	}
+	if !reflect.DeepEqual(wrappedOrder, typedOrder) {
+		t.Errorf("Expected pop order %v, but got %v", typedOrder, wrappedOrder)
+	}
+	if _, ok := wrapped.Pop(); ok {
+		t.Errorf("Expected Pop on empty heap to return false")
+	}
+	if _, ok := wrapped.Peek(); ok {
+		t.Errorf("Expected Peek on empty heap to return false")
+	}
+}
+
+func TestInterfaceHeapElementTypeMismatch(t *testing.T) {
+	std := intHeap{3, 1, 2}
+	wrapped := NewInterfaceHeap[string](&std)
+
+	if _, ok := wrapped.Peek(); ok {
+		t.Errorf("Expected Peek to return false for an element that is not a string")
+	}
+	if wrapped.Len() != 3 {
+		t.Errorf("Expected Peek to leave 3 elements, but got %d", wrapped.Len())
+	}
+	if _, ok := wrapped.Pop(); ok {
+		t.Errorf("Expected Pop to return false for an element that is not a string")
+	}
+	if wrapped.Len() != 2 || std[0] != 2 {
+		t.Errorf("Expected the mismatched root to be removed, but got %v", std)
+	}
+}
+
+// personHeap implements heap.Interface over people, ordered by name rather than by date of birth.
+type personHeap []Person
+
+func (h personHeap) Len() int           { return len(h) }
+func (h personHeap) Less(i, j int) bool { return h[i].Name < h[j].Name }
+func (h personHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *personHeap) Push(x any)        { *h = append(*h, x.(Person)) }
+func (h *personHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
+func TestInterfaceHeapWithComparatorMatchesHeap(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	people := make([]Person, 50)
+	for i := range people {
+		people[i] = Person{Name: fmt.Sprint(i), Dob: time.Unix(random.Int63n(1<<31), 0)}
+	}
+
+	for _, heapType := range []HeapType{MinHeap, MaxHeap} {
+		std := personHeap(append([]Person(nil), people[:25]...))
+		wrapped := NewInterfaceHeapWithComparator[Person](&std, heapType, func(i int) Person { return std[i] }, PersonComparator{})
+		typed := NewHeapWithComparator[Person](heapType, append([]Person(nil), people[:25]...), PersonComparator{})
+		for _, person := range people[25:] {
+			wrapped.Push(person)
+			typed.Push(person)
+		}
+
+		var wrappedOrder, typedOrder []Person
+		for wrapped.Len() > 0 {
+			expected, _ := typed.Peek()
+			if peeked, _ := wrapped.Peek(); peeked != expected {
+				t.Errorf("%v: expected Peek to return %v, but got %v", heapType, expected, peeked)
+			}
+			item, _ := wrapped.Pop()
+			wrappedOrder = append(wrappedOrder, item)
+			item, _ = typed.Pop()
+			typedOrder = append(typedOrder, item)
+		}
+		if !reflect.DeepEqual(wrappedOrder, typedOrder) {
+			t.Errorf("%v: expected pop order %v, but got %v", heapType, typedOrder, wrappedOrder)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the elements of the heap in heap-array order, without modifying the heap.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range h.Data {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the elements of the heap in priority order (ascending for a MinHeap, descending
+// for a MaxHeap), without modifying the heap.  It walks the heap with an auxiliary frontier heap of indices, so
+// yielding the first k elements costs O(k log k) regardless of the size of the heap.  The heap must not be modified
+// while the iteration is in progress.
+func (h *Heap[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if h.size() == 0 {
+			return
+		}
+		// the next element in priority order is always a child of one already yielded, so the frontier only ever
+		// holds the children of yielded elements
+		frontier := NewHeapWithComparator[int](h.heapType, []int{0}, indexComparator[T]{data: h.Data, comparator: h.comparator})
+		for i, ok := frontier.Pop(); ok; i, ok = frontier.Pop() {
+			if !yield(h.Data[i]) {
+				return
+			}
+			first := h.firstChild(i)
+			for child := first; child < first+h.arity && child < h.size(); child++ {
+				frontier.Push(child)
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that pops the elements of the heap in priority order as it goes.  Stopping the iteration
+// early leaves the remaining elements in the heap.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item, ok := h.Pop(); ok; item, ok = h.Pop() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// indexComparator orders indices into data by the elements they refer to.
+type indexComparator[T any] struct {
+	data       []T
+	comparator Comparator[T]
+}
+
+func (c indexComparator[T]) GreaterThan(a, b int) bool {
+	return c.comparator.GreaterThan(c.data[a], c.data[b])
+}
+func (c indexComparator[T]) LessThan(a, b int) bool {
+	return c.comparator.LessThan(c.data[a], c.data[b])
+}
+func (c indexComparator[T]) EqualTo(a, b int) bool {
+	return c.comparator.EqualTo(c.data[a], c.data[b])
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestHeapAll(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{10, 15, 20, 5})
+
+	expected := []int{5, 10, 20, 15}
+	if items := slices.Collect(heap.All()); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+}
+
+func TestMinHeapSorted(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 2, 9, 1, 5, 6, 3})
+	before := slices.Clone(heap.Data)
+
+	expected := []int{1, 2, 3, 5, 5, 6, 9}
+	if items := slices.Collect(heap.Sorted()); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+	if !reflect.DeepEqual(heap.Data, before) {
+		t.Errorf("Expected Sorted to leave the heap untouched as %v, but got %v", before, heap.Data)
+	}
+}
+
+func TestMaxHeapSorted(t *testing.T) {
+	heap := NewDaryHeap[int](4, MaxHeap, []int{5, 2, 9, 1, 5, 6, 3})
+
+	expected := []int{9, 6, 5, 5, 3, 2, 1}
+	if items := slices.Collect(heap.Sorted()); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+}
+
+func TestHeapSortedEmpty(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{})
+	if items := slices.Collect(heap.Sorted()); len(items) != 0 {
+		t.Errorf("Expected no elements, but got %v", items)
+	}
+}
+
+func TestHeapSortedStopsEarly(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 2, 9, 1, 5, 6, 3})
+
+	var items []int
+	for item := range heap.Sorted() {
+		if item > 3 {
+			break
+		}
+		items = append(items, item)
+	}
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+}
+
+func TestHeapDrain(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 2, 9, 1})
+
+	var items []int
+	for item := range heap.Drain() {
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+
+	expected := []int{1, 2}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+	if heap.Len() != 2 || !heap.IsValidHeap() {
+		t.Errorf("Expected 2 remaining elements in a valid heap, but got %v", heap.Data)
+	}
+
+	expected = []int{5, 9}
+	if items := slices.Collect(heap.Drain()); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+	if heap.Len() != 0 {
+		t.Errorf("Expected an empty heap, but got %v", heap.Data)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !heapdebug
+
+package types
+
+// heapDebug is disabled by default; build with -tags heapdebug to check the heap invariant after every mutation.
+const heapDebug = false
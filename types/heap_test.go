@@ -3,6 +3,7 @@ package types
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestMinHeapWithSingleElement(t *testing.T) {
@@ -161,7 +162,7 @@ func TestMinHeapInsertWithDuplicateValues(t *testing.T) {
 		t.Errorf("Heap property violated: %v", heap.Data)
 	}
 
-	expected := []int{5, 5, 10, 10, 5}
+	expected := []int{5, 5, 5, 10, 10}
 	if !reflect.DeepEqual(heap.Data, expected) {
 		t.Errorf("Expected %v, but got %v", expected, heap.Data)
 	}
@@ -379,7 +380,7 @@ func TestMaxHeapInsertWithDuplicateValues(t *testing.T) {
 		t.Errorf("Heap property violated: %v", heap.Data)
 	}
 
-	expected := []int{10, 10, 5, 5, 10}
+	expected := []int{10, 10, 10, 5, 5}
 	if !reflect.DeepEqual(heap.Data, expected) {
 		t.Errorf("Expected %v, but got %v", expected, heap.Data)
 	}
@@ -488,3 +489,249 @@ func TestMaxHeapDeleteNegativeIndex(t *testing.T) {
 		t.Errorf("Expected %v, but got %v", expected, heap.Data)
 	}
 }
+
+func TestMinHeapWithComparator(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	heap := NewHeapWithComparator[Person](MinHeap, []Person{charlie, alice, eve, bob}, PersonComparator{})
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	expected := []Person{bob, alice, eve, charlie}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestMaxHeapWithComparator(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	heap := NewHeapWithComparator[Person](MaxHeap, []Person{bob, eve, alice}, PersonComparator{})
+	heap.Insert(charlie)
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	if heap.Data[0] != charlie {
+		t.Errorf("Expected %v at the root, but got %v", charlie, heap.Data[0])
+	}
+}
+
+func TestMinHeapInsertComparesWithParent(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{0, 10, 1, 30})
+	heap.Insert(5)
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	expected := []int{0, 5, 1, 30, 10}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestMinHeapDeleteMovesReplacementUp(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{0, 10, 1, 11, 12, 2, 3})
+	heap.Delete(3)
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	expected := []int{0, 3, 1, 10, 12, 2}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestMinHeapPop(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 2, 9, 1, 5, 6})
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, ok := heap.Pop()
+		if !ok {
+			t.Fatalf("Expected Pop to succeed with %d elements remaining", heap.Len())
+		}
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated: %v", heap.Data)
+		}
+		popped = append(popped, item)
+	}
+
+	expected := []int{1, 2, 5, 5, 6, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestMaxHeapPop(t *testing.T) {
+	heap := NewHeap[int](MaxHeap, []int{5, 2, 9, 1, 5, 6})
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		popped = append(popped, item)
+	}
+
+	expected := []int{9, 6, 5, 5, 2, 1}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestHeapPopAndPeekEmpty(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{})
+
+	if item, ok := heap.Peek(); ok || item != 0 {
+		t.Errorf("Expected Peek on empty heap to return (0, false), but got (%v, %v)", item, ok)
+	}
+	if item, ok := heap.Pop(); ok || item != 0 {
+		t.Errorf("Expected Pop on empty heap to return (0, false), but got (%v, %v)", item, ok)
+	}
+}
+
+func TestHeapPeek(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{10, 5, 20})
+
+	item, ok := heap.Peek()
+	if !ok || item != 5 {
+		t.Errorf("Expected (5, true), but got (%v, %v)", item, ok)
+	}
+	if heap.Len() != 3 {
+		t.Errorf("Expected Peek to leave 3 elements, but got %d", heap.Len())
+	}
+}
+
+func TestHeapReplace(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 10, 20})
+
+	item, ok := heap.Replace(30)
+	if !ok || item != 5 {
+		t.Errorf("Expected (5, true), but got (%v, %v)", item, ok)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	expected := []int{10, 30, 20}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestHeapReplaceEmpty(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{})
+
+	if _, ok := heap.Replace(30); ok {
+		t.Errorf("Expected Replace on empty heap to return false")
+	}
+
+	expected := []int{30}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestHeapPushPop(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 10, 20})
+
+	// item ranks before the root, so it is returned without touching the heap
+	if item := heap.PushPop(1); item != 1 {
+		t.Errorf("Expected 1, but got %v", item)
+	}
+	// item ranks after the root, so the root is returned and item takes its place
+	if item := heap.PushPop(15); item != 5 {
+		t.Errorf("Expected 5, but got %v", item)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	expected := []int{10, 15, 20}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestHeapClear(t *testing.T) {
+	heap := NewHeap[int](MaxHeap, []int{5, 10, 20})
+	heap.Clear()
+	if heap.Len() != 0 {
+		t.Errorf("Expected an empty heap, but got %v", heap.Data)
+	}
+
+	heap.Push(7)
+	heap.Push(9)
+	if item, _ := heap.Peek(); item != 9 {
+		t.Errorf("Expected 9, but got %v", item)
+	}
+}
+
+func TestDaryMinHeapWithMultipleElements(t *testing.T) {
+	heap := NewDaryHeap[int](4, MinHeap, []int{50, 40, 30, 20, 10, 5, 15})
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	expected := []int{5, 15, 30, 20, 10, 40, 50}
+	if !reflect.DeepEqual(heap.Data, expected) {
+		t.Errorf("Expected %v, but got %v", expected, heap.Data)
+	}
+}
+
+func TestDaryHeapIsValidHeap(t *testing.T) {
+	// valid as a 4-ary heap (root's children are indices 1-4), but not as a binary heap (index 3's parent is 1)
+	data := []int{1, 5, 2, 3, 4}
+	if !(&Heap[int]{Data: data, arity: 4, compare: DefaultComparator[int]{}.LessThan}).IsValidHeap() {
+		t.Errorf("Expected %v to be a valid 4-ary min heap", data)
+	}
+	if (&Heap[int]{Data: data, arity: 2, compare: DefaultComparator[int]{}.LessThan}).IsValidHeap() {
+		t.Errorf("Expected %v to be an invalid binary min heap", data)
+	}
+}
+
+func TestDaryHeapPopOrder(t *testing.T) {
+	for _, arity := range []int{2, 3, 4, 8} {
+		for _, heapType := range []HeapType{MinHeap, MaxHeap} {
+			heap := NewDaryHeap[int](arity, heapType, []int{9, 4, 7, 1, 8, 2, 2, 6, 3, 5, 0})
+			heap.Push(-1)
+			heap.Push(11)
+			if !heap.IsValidHeap() {
+				t.Errorf("arity %d: Heap property violated: %v", arity, heap.Data)
+			}
+
+			var popped []int
+			for heap.Len() > 0 {
+				item, _ := heap.Pop()
+				popped = append(popped, item)
+				if !heap.IsValidHeap() {
+					t.Errorf("arity %d: Heap property violated: %v", arity, heap.Data)
+				}
+			}
+
+			expected := []int{-1, 0, 1, 2, 2, 3, 4, 5, 6, 7, 8, 9, 11}
+			if heapType == MaxHeap {
+				expected = []int{11, 9, 8, 7, 6, 5, 4, 3, 2, 2, 1, 0, -1}
+			}
+			if !reflect.DeepEqual(popped, expected) {
+				t.Errorf("arity %d: Expected %v, but got %v", arity, expected, popped)
+			}
+		}
+	}
+}
+
+func TestDaryHeapWithInvalidArity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected NewDaryHeap to panic for arity 1")
+		}
+	}()
+	NewDaryHeap[int](1, MinHeap, []int{})
+}
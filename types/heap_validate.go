@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HeapViolation describes a parent/child pair that breaks the heap property, i.e. a child that ranks before its parent.
+type HeapViolation[T any] struct {
+	Parent      int
+	Child       int
+	ParentValue T
+	ChildValue  T
+}
+
+func (v HeapViolation[T]) String() string {
+	return fmt.Sprintf("child Data[%d]=%v ranks before parent Data[%d]=%v", v.Child, v.ChildValue, v.Parent, v.ParentValue)
+}
+
+// HeapInvariantError is returned by Validate and ValidateAll when the heap property does not hold, typically because
+// Data was mutated directly.
+type HeapInvariantError[T any] struct {
+	HeapType   HeapType
+	Violations []HeapViolation[T]
+}
+
+func (e *HeapInvariantError[T]) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		descriptions[i] = violation.String()
+	}
+	return fmt.Sprintf("types: %v property violated: %s", e.HeapType, strings.Join(descriptions, "; "))
+}
+
+// Validate checks the heap property and returns a *HeapInvariantError describing the first violating parent/child
+// pair, or nil if the heap is valid.
+func (h *Heap[T]) Validate() error {
+	return h.invariantError(1)
+}
+
+// ValidateAll checks the heap property and returns a *HeapInvariantError describing every violating parent/child
+// pair, or nil if the heap is valid.
+func (h *Heap[T]) ValidateAll() error {
+	return h.invariantError(-1)
+}
+
+func (h *Heap[T]) invariantError(limit int) error {
+	violations := h.violations(limit)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &HeapInvariantError[T]{HeapType: h.heapType, Violations: violations}
+}
+
+// violations returns up to limit parent/child pairs that break the heap property, in index order.  A negative limit
+// returns all of them.
+func (h *Heap[T]) violations(limit int) []HeapViolation[T] {
+	var violations []HeapViolation[T]
+	for i := 1; i < h.size() && len(violations) != limit; i++ {
+		p := h.parent(i)
+		if h.compare(h.Data[i], h.Data[p]) {
+			violations = append(violations, HeapViolation[T]{Parent: p, Child: i, ParentValue: h.Data[p], ChildValue: h.Data[i]})
+		}
+	}
+	return violations
+}
+
+// checkInvariant panics with the result of Validate after a mutation when built with the heapdebug tag.  Without
+// the tag heapDebug is a false constant and the check compiles away.
+func (h *Heap[T]) checkInvariant() {
+	if heapDebug {
+		if err := h.Validate(); err != nil {
+			panic(err)
+		}
+	}
+}
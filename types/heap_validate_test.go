@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHeapValidateValid(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{5, 2, 9, 1})
+	if err := heap.Validate(); err != nil {
+		t.Errorf("Expected a valid heap, but got %v", err)
+	}
+	if err := heap.ValidateAll(); err != nil {
+		t.Errorf("Expected a valid heap, but got %v", err)
+	}
+}
+
+func TestHeapValidateFirstViolation(t *testing.T) {
+	heap := NewHeap[int](MinHeap, []int{1, 2, 3, 4, 5})
+	heap.Data[1], heap.Data[4] = 9, 0
+
+	err := heap.Validate()
+	var invariantErr *HeapInvariantError[int]
+	if !errors.As(err, &invariantErr) {
+		t.Fatalf("Expected a *HeapInvariantError, but got %v", err)
+	}
+
+	// Data[4]=0 also ranks before its parent, but only the first violation in index order is reported
+	expected := []HeapViolation[int]{{Parent: 1, Child: 3, ParentValue: 9, ChildValue: 4}}
+	if invariantErr.HeapType != MinHeap || !reflect.DeepEqual(invariantErr.Violations, expected) {
+		t.Errorf("Expected %v violations %v, but got %v %v", MinHeap, expected, invariantErr.HeapType, invariantErr.Violations)
+	}
+}
+
+func TestHeapValidateAllViolations(t *testing.T) {
+	heap := NewHeap[int](MaxHeap, []int{9, 8, 7, 6, 5})
+	heap.Data[0], heap.Data[4] = 0, 10
+
+	err := heap.ValidateAll()
+	var invariantErr *HeapInvariantError[int]
+	if !errors.As(err, &invariantErr) {
+		t.Fatalf("Expected a *HeapInvariantError, but got %v", err)
+	}
+
+	expected := []HeapViolation[int]{
+		{Parent: 0, Child: 1, ParentValue: 0, ChildValue: 8},
+		{Parent: 0, Child: 2, ParentValue: 0, ChildValue: 7},
+		{Parent: 1, Child: 4, ParentValue: 8, ChildValue: 10},
+	}
+	if !reflect.DeepEqual(invariantErr.Violations, expected) {
+		t.Errorf("Expected violations %v, but got %v", expected, invariantErr.Violations)
+	}
+	if heap.IsValidHeap() {
+		t.Errorf("Expected IsValidHeap to agree with ValidateAll")
+	}
+
+	message := err.Error()
+	for _, fragment := range []string{"MaxHeap", "child Data[1]=8 ranks before parent Data[0]=0", "child Data[4]=10"} {
+		if !strings.Contains(message, fragment) {
+			t.Errorf("Expected error message %q to contain %q", message, fragment)
+		}
+	}
+}
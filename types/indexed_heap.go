@@ -0,0 +1,144 @@
+package types
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Handle identifies an element pushed onto an IndexedHeap.  It remains valid, regardless of where the element
+// moves within the heap, until the element is popped or removed.
+type Handle int
+
+// indexedEntry pairs a value with its handle and its current position in the underlying heap.
+type indexedEntry[T any] struct {
+	value  T
+	handle Handle
+	index  int
+}
+
+// entryComparator orders heap entries by their values using the wrapped comparator.
+type entryComparator[T any] struct {
+	comparator Comparator[T]
+}
+
+func (e entryComparator[T]) GreaterThan(a, b *indexedEntry[T]) bool {
+	return e.comparator.GreaterThan(a.value, b.value)
+}
+func (e entryComparator[T]) LessThan(a, b *indexedEntry[T]) bool {
+	return e.comparator.LessThan(a.value, b.value)
+}
+func (e entryComparator[T]) EqualTo(a, b *indexedEntry[T]) bool {
+	return e.comparator.EqualTo(a.value, b.value)
+}
+
+// IndexedHeap is a priority queue that hands out a stable Handle for every pushed element, allowing the element
+// to be updated or removed in O(log n) after it has moved through the heap.
+type IndexedHeap[T any] struct {
+	heap    *Heap[*indexedEntry[T]]
+	entries map[Handle]*indexedEntry[T]
+	next    Handle
+}
+
+// NewIndexedHeap creates a new, empty min or max indexed heap using the default comparator.
+func NewIndexedHeap[T constraints.Ordered](heapType HeapType) *IndexedHeap[T] {
+	return NewIndexedHeapWithComparator[T](heapType, DefaultComparator[T]{})
+}
+
+// NewIndexedHeapWithComparator creates a new, empty min or max indexed heap of any type using a custom comparator.
+func NewIndexedHeapWithComparator[T any](heapType HeapType, comparator Comparator[T]) *IndexedHeap[T] {
+	heap := NewHeapWithComparator[*indexedEntry[T]](heapType, nil, entryComparator[T]{comparator: comparator})
+	// keep each entry's index in step with its position as the sift logic moves it around
+	heap.onSwap = func(i, j int) {
+		heap.Data[i].index = i
+		heap.Data[j].index = j
+	}
+	return &IndexedHeap[T]{heap: heap, entries: make(map[Handle]*indexedEntry[T])}
+}
+
+// Push adds a new item to the heap and returns the handle by which it can later be updated or removed.
+func (h *IndexedHeap[T]) Push(item T) Handle {
+	entry := &indexedEntry[T]{value: item, handle: h.next, index: h.heap.Len()}
+	h.next++
+	h.entries[entry.handle] = entry
+	h.heap.Insert(entry)
+	return entry.handle
+}
+
+// Pop removes and returns the root element of the heap along with its handle, which is no longer valid afterwards.
+// The boolean result is false if the heap is empty.
+func (h *IndexedHeap[T]) Pop() (T, Handle, bool) {
+	entry, ok := h.heap.Pop()
+	if !ok {
+		var zero T
+		return zero, -1, false
+	}
+	delete(h.entries, entry.handle)
+	return entry.value, entry.handle, true
+}
+
+// Peek returns the root element of the heap and its handle without removing it.
+// The boolean result is false if the heap is empty.
+func (h *IndexedHeap[T]) Peek() (T, Handle, bool) {
+	entry, ok := h.heap.Peek()
+	if !ok {
+		var zero T
+		return zero, -1, false
+	}
+	return entry.value, entry.handle, true
+}
+
+// Get returns the element identified by handle.  The boolean result is false if the handle is not in the heap.
+func (h *IndexedHeap[T]) Get(handle Handle) (T, bool) {
+	entry, ok := h.entries[handle]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Update replaces the element identified by handle with value and restores the heap property, moving the element
+// up or down as needed.  This covers both decrease-key and increase-key.  It returns false if the handle is not in
+// the heap.
+func (h *IndexedHeap[T]) Update(handle Handle, value T) bool {
+	entry, ok := h.entries[handle]
+	if !ok {
+		return false
+	}
+	entry.value = value
+	h.heap.fix(entry.index)
+	return true
+}
+
+// Remove deletes the element identified by handle from the heap and returns it.
+// The boolean result is false if the handle is not in the heap.
+func (h *IndexedHeap[T]) Remove(handle Handle) (T, bool) {
+	entry, ok := h.entries[handle]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	delete(h.entries, handle)
+	h.heap.Delete(entry.index)
+	return entry.value, true
+}
+
+// Contains reports whether the element identified by handle is still in the heap.
+func (h *IndexedHeap[T]) Contains(handle Handle) bool {
+	_, ok := h.entries[handle]
+	return ok
+}
+
+// Len returns the number of elements in the heap.
+func (h *IndexedHeap[T]) Len() int {
+	return h.heap.Len()
+}
+
+// IsValidHeap checks if the heap property is maintained and every handle points at its element's position.
+func (h *IndexedHeap[T]) IsValidHeap() bool {
+	for i, entry := range h.heap.Data {
+		if entry.index != i || h.entries[entry.handle] != entry {
+			return false
+		}
+	}
+	return len(h.entries) == h.heap.Len() && h.heap.IsValidHeap()
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndexedHeapPushAndPop(t *testing.T) {
+	heap := NewIndexedHeap[int](MinHeap)
+	for _, item := range []int{5, 2, 9, 1, 5, 6} {
+		heap.Push(item)
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated after pushing %v", item)
+		}
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _, _ := heap.Pop()
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated after popping %v", item)
+		}
+		popped = append(popped, item)
+	}
+
+	expected := []int{1, 2, 5, 5, 6, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestIndexedHeapPopEmpty(t *testing.T) {
+	heap := NewIndexedHeap[int](MinHeap)
+	if _, _, ok := heap.Pop(); ok {
+		t.Errorf("Expected Pop on empty heap to return false")
+	}
+	if _, _, ok := heap.Peek(); ok {
+		t.Errorf("Expected Peek on empty heap to return false")
+	}
+}
+
+func TestIndexedHeapUpdateDecreaseKey(t *testing.T) {
+	heap := NewIndexedHeap[int](MinHeap)
+	heap.Push(10)
+	heap.Push(20)
+	handle := heap.Push(30)
+	heap.Push(40)
+
+	if !heap.Update(handle, 5) {
+		t.Fatalf("Expected Update to find handle %v", handle)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated after update")
+	}
+
+	item, popped, _ := heap.Peek()
+	if item != 5 || popped != handle {
+		t.Errorf("Expected (5, %v) at the root, but got (%v, %v)", handle, item, popped)
+	}
+}
+
+func TestIndexedHeapUpdateIncreaseKey(t *testing.T) {
+	heap := NewIndexedHeap[int](MinHeap)
+	handle := heap.Push(10)
+	heap.Push(20)
+	heap.Push(30)
+
+	heap.Update(handle, 25)
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated after update")
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _, _ := heap.Pop()
+		popped = append(popped, item)
+	}
+
+	expected := []int{20, 25, 30}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestIndexedHeapRemove(t *testing.T) {
+	heap := NewIndexedHeap[int](MaxHeap)
+	handles := map[int]Handle{}
+	for _, item := range []int{5, 2, 9, 1, 6} {
+		handles[item] = heap.Push(item)
+	}
+
+	item, ok := heap.Remove(handles[9])
+	if !ok || item != 9 {
+		t.Errorf("Expected (9, true), but got (%v, %v)", item, ok)
+	}
+	if heap.Contains(handles[9]) {
+		t.Errorf("Expected removed handle to no longer be contained")
+	}
+	if _, ok := heap.Remove(handles[9]); ok {
+		t.Errorf("Expected second Remove of the same handle to return false")
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated after remove")
+	}
+
+	if item, _, _ := heap.Peek(); item != 6 {
+		t.Errorf("Expected 6 at the root, but got %v", item)
+	}
+}
+
+func TestIndexedHeapHandlesAfterPop(t *testing.T) {
+	heap := NewIndexedHeap[int](MinHeap)
+	first := heap.Push(1)
+	second := heap.Push(2)
+
+	_, popped, _ := heap.Pop()
+	if popped != first {
+		t.Errorf("Expected handle %v to be popped, but got %v", first, popped)
+	}
+	if heap.Contains(first) || heap.Update(first, 0) {
+		t.Errorf("Expected popped handle %v to be invalid", first)
+	}
+	if item, ok := heap.Get(second); !ok || item != 2 {
+		t.Errorf("Expected (2, true), but got (%v, %v)", item, ok)
+	}
+}
+
+func TestIndexedHeapShortestPaths(t *testing.T) {
+	type edge struct{ to, weight int }
+	graph := map[int][]edge{
+		0: {{1, 4}, {2, 1}},
+		2: {{1, 2}, {3, 5}},
+		1: {{3, 1}},
+	}
+
+	type vertex struct{ id, dist int }
+	heap := NewIndexedHeapWithComparator[vertex](MinHeap, vertexComparator[vertex]{dist: func(v vertex) int { return v.dist }})
+	handles := map[int]Handle{0: heap.Push(vertex{0, 0})}
+	dist := map[int]int{0: 0}
+
+	for heap.Len() > 0 {
+		current, _, _ := heap.Pop()
+		for _, e := range graph[current.id] {
+			candidate := current.dist + e.weight
+			if known, seen := dist[e.to]; seen && known <= candidate {
+				continue
+			}
+			dist[e.to] = candidate
+			if handle, queued := handles[e.to]; queued && heap.Contains(handle) {
+				heap.Update(handle, vertex{e.to, candidate})
+			} else {
+				handles[e.to] = heap.Push(vertex{e.to, candidate})
+			}
+		}
+	}
+
+	expected := map[int]int{0: 0, 1: 3, 2: 1, 3: 4}
+	if !reflect.DeepEqual(dist, expected) {
+		t.Errorf("Expected %v, but got %v", expected, dist)
+	}
+}
+
+type vertexComparator[T any] struct {
+	dist func(T) int
+}
+
+func (v vertexComparator[T]) GreaterThan(a, b T) bool { return v.dist(a) > v.dist(b) }
+func (v vertexComparator[T]) LessThan(a, b T) bool    { return v.dist(a) < v.dist(b) }
+func (v vertexComparator[T]) EqualTo(a, b T) bool     { return v.dist(a) == v.dist(b) }
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// MinMaxHeap is a double-ended priority queue giving access to both its smallest and largest elements.  Levels
+// alternate between min levels (even depths, starting with the root) and max levels (odd depths): every element on a
+// min level is less than or equal to all of its descendants, and every element on a max level is greater than or
+// equal to all of its descendants.  The minimum is therefore the root and the maximum one of the root's children.
+type MinMaxHeap[T any] struct {
+	Data       []T
+	comparator Comparator[T]
+}
+
+// NewMinMaxHeap creates a new min-max heap from the provided elements using the default comparator.
+func NewMinMaxHeap[T constraints.Ordered](elements []T) *MinMaxHeap[T] {
+	return NewMinMaxHeapWithComparator[T](elements, DefaultComparator[T]{})
+}
+
+// NewMinMaxHeapWithComparator creates a new min-max heap from the provided elements of any type using a custom
+// comparator.
+func NewMinMaxHeapWithComparator[T any](elements []T, comparator Comparator[T]) *MinMaxHeap[T] {
+	heap := &MinMaxHeap[T]{Data: elements, comparator: comparator}
+	for i := (len(elements) - 2) / 2; i >= 0; i-- {
+		heap.bubbleDown(i)
+	}
+	return heap
+}
+
+// Push adds a new item to the heap and maintains the min-max heap property.
+func (h *MinMaxHeap[T]) Push(item T) {
+	h.Data = append(h.Data, item)
+	h.bubbleUp(len(h.Data) - 1)
+}
+
+// PeekMin returns the smallest element without removing it.  The boolean result is false if the heap is empty.
+func (h *MinMaxHeap[T]) PeekMin() (T, bool) {
+	if len(h.Data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Data[0], true
+}
+
+// PeekMax returns the largest element without removing it.  The boolean result is false if the heap is empty.
+func (h *MinMaxHeap[T]) PeekMax() (T, bool) {
+	if len(h.Data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.Data[h.maxIndex()], true
+}
+
+// PopMin removes and returns the smallest element.  The boolean result is false if the heap is empty.
+func (h *MinMaxHeap[T]) PopMin() (T, bool) {
+	if len(h.Data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(0), true
+}
+
+// PopMax removes and returns the largest element.  The boolean result is false if the heap is empty.
+func (h *MinMaxHeap[T]) PopMax() (T, bool) {
+	if len(h.Data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.removeAt(h.maxIndex()), true
+}
+
+// Len returns the number of elements in the heap.
+func (h *MinMaxHeap[T]) Len() int {
+	return len(h.Data)
+}
+
+// maxIndex returns the index of the largest element, which is the root or one of its children.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch {
+	case len(h.Data) == 1:
+		return 0
+	case len(h.Data) == 2 || h.comparator.GreaterThan(h.Data[1], h.Data[2]):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// removeAt replaces the element at index i with the last element, shrinks the heap and restores the heap property.
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	item := h.Data[i]
+	last := len(h.Data) - 1
+	h.Data[i] = h.Data[last]
+	h.Data = h.Data[:last]
+	if i < last {
+		h.bubbleDown(i)
+	}
+	return item
+}
+
+// bubbleUp restores the heap property by moving the element at index i up.  The element is first checked against
+// its parent, which lives on the opposite kind of level, and then moved up through its grandparents.
+func (h *MinMaxHeap[T]) bubbleUp(i int) {
+	if i == 0 {
+		return
+	}
+	p := parentIndex(i)
+	if isMinLevel(i) {
+		if h.comparator.GreaterThan(h.Data[i], h.Data[p]) {
+			h.Data[i], h.Data[p] = h.Data[p], h.Data[i]
+			h.bubbleUpWith(p, h.comparator.GreaterThan)
+		} else {
+			h.bubbleUpWith(i, h.comparator.LessThan)
+		}
+	} else {
+		if h.comparator.LessThan(h.Data[i], h.Data[p]) {
+			h.Data[i], h.Data[p] = h.Data[p], h.Data[i]
+			h.bubbleUpWith(p, h.comparator.LessThan)
+		} else {
+			h.bubbleUpWith(i, h.comparator.GreaterThan)
+		}
+	}
+}
+
+// bubbleUpWith moves the element at index i up through its grandparents while it ranks before them.
+func (h *MinMaxHeap[T]) bubbleUpWith(i int, before func(a, b T) bool) {
+	for i > 2 {
+		grandparent := parentIndex(parentIndex(i))
+		if !before(h.Data[i], h.Data[grandparent]) {
+			return
+		}
+		h.Data[i], h.Data[grandparent] = h.Data[grandparent], h.Data[i]
+		i = grandparent
+	}
+}
+
+// bubbleDown restores the heap property by moving the element at index i down.
+func (h *MinMaxHeap[T]) bubbleDown(i int) {
+	if isMinLevel(i) {
+		h.bubbleDownWith(i, h.comparator.LessThan)
+	} else {
+		h.bubbleDownWith(i, h.comparator.GreaterThan)
+	}
+}
+
+// bubbleDownWith moves the element at index i down, swapping it with whichever child or grandchild ranks first.
+func (h *MinMaxHeap[T]) bubbleDownWith(i int, before func(a, b T) bool) {
+	for {
+		first := i
+		// children are 2i+1 and 2i+2, grandchildren are 4i+3 through 4i+6
+		for _, candidate := range [...]int{2*i + 1, 2*i + 2, 4*i + 3, 4*i + 4, 4*i + 5, 4*i + 6} {
+			if candidate < len(h.Data) && before(h.Data[candidate], h.Data[first]) {
+				first = candidate
+			}
+		}
+		if first == i {
+			return
+		}
+
+		h.Data[i], h.Data[first] = h.Data[first], h.Data[i]
+		if first <= 2*i+2 {
+			// a child has no descendants on the same kind of level as i that could be violated
+			return
+		}
+		// the element moved to the grandchild may now rank before its parent, which is on the opposite kind of level
+		if p := parentIndex(first); before(h.Data[p], h.Data[first]) {
+			h.Data[p], h.Data[first] = h.Data[first], h.Data[p]
+		}
+		i = first
+	}
+}
+
+// IsValidHeap checks if the min-max heap property is maintained for the entire heap.
+func (h *MinMaxHeap[T]) IsValidHeap() bool {
+	// checking every element against its parent and grandparent covers all descendants transitively
+	for i := 1; i < len(h.Data); i++ {
+		p := parentIndex(i)
+		if h.violates(p, i) || (p > 0 && h.violates(parentIndex(p), i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// violates reports whether the element at index i ranks before its ancestor at index ancestor, given the kind of
+// level the ancestor is on.
+func (h *MinMaxHeap[T]) violates(ancestor, i int) bool {
+	if isMinLevel(ancestor) {
+		return h.comparator.LessThan(h.Data[i], h.Data[ancestor])
+	}
+	return h.comparator.GreaterThan(h.Data[i], h.Data[ancestor])
+}
+
+// returns the parent for a given index in a binary heap, converting from 0 to 1 based indexing
+func parentIndex(i int) int {
+	return (i - 1) / 2
+}
+
+// isMinLevel reports whether index i lies on an even depth, the root being at depth zero.
+func isMinLevel(i int) bool {
+	return (bits.Len(uint(i+1))-1)%2 == 0
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMinMaxHeapWithMultipleElements(t *testing.T) {
+	heap := NewMinMaxHeap[int]([]int{50, 40, 30, 20, 10, 5, 15, 45, 35, 25})
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated: %v", heap.Data)
+	}
+
+	if item, _ := heap.PeekMin(); item != 5 {
+		t.Errorf("Expected minimum 5, but got %v", item)
+	}
+	if item, _ := heap.PeekMax(); item != 50 {
+		t.Errorf("Expected maximum 50, but got %v", item)
+	}
+}
+
+func TestMinMaxHeapEmpty(t *testing.T) {
+	heap := NewMinMaxHeap[int]([]int{})
+	if _, ok := heap.PeekMin(); ok {
+		t.Errorf("Expected PeekMin on empty heap to return false")
+	}
+	if _, ok := heap.PeekMax(); ok {
+		t.Errorf("Expected PeekMax on empty heap to return false")
+	}
+	if _, ok := heap.PopMin(); ok {
+		t.Errorf("Expected PopMin on empty heap to return false")
+	}
+	if _, ok := heap.PopMax(); ok {
+		t.Errorf("Expected PopMax on empty heap to return false")
+	}
+}
+
+func TestMinMaxHeapSingleElement(t *testing.T) {
+	heap := NewMinMaxHeap[int]([]int{})
+	heap.Push(7)
+
+	if item, _ := heap.PeekMax(); item != 7 {
+		t.Errorf("Expected maximum 7, but got %v", item)
+	}
+	if item, _ := heap.PopMax(); item != 7 {
+		t.Errorf("Expected 7, but got %v", item)
+	}
+	if heap.Len() != 0 {
+		t.Errorf("Expected an empty heap, but got %v", heap.Data)
+	}
+}
+
+func TestMinMaxHeapIsValidHeap(t *testing.T) {
+	// 8 is a grandchild of the max-level node 6
+	data := []int{1, 9, 6, 2, 3, 8, 4}
+	if (&MinMaxHeap[int]{Data: data, comparator: DefaultComparator[int]{}}).IsValidHeap() {
+		t.Errorf("Expected %v to be an invalid min-max heap", data)
+	}
+
+	data = []int{1, 9, 8, 2, 3, 6, 4}
+	if !(&MinMaxHeap[int]{Data: data, comparator: DefaultComparator[int]{}}).IsValidHeap() {
+		t.Errorf("Expected %v to be a valid min-max heap", data)
+	}
+}
+
+func TestMinMaxHeapPopMinAndPopMax(t *testing.T) {
+	heap := NewMinMaxHeap[int]([]int{})
+	for _, item := range []int{5, 2, 9, 1, 5, 6, 3} {
+		heap.Push(item)
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated after pushing %v: %v", item, heap.Data)
+		}
+	}
+
+	var mins, maxes []int
+	for heap.Len() > 0 {
+		item, _ := heap.PopMin()
+		mins = append(mins, item)
+		if item, ok := heap.PopMax(); ok {
+			maxes = append(maxes, item)
+		}
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated: %v", heap.Data)
+		}
+	}
+
+	if expected := []int{1, 2, 3, 5}; !reflect.DeepEqual(mins, expected) {
+		t.Errorf("Expected minimums %v, but got %v", expected, mins)
+	}
+	if expected := []int{9, 6, 5}; !reflect.DeepEqual(maxes, expected) {
+		t.Errorf("Expected maximums %v, but got %v", expected, maxes)
+	}
+}
+
+func TestMinMaxHeapRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	elements := make([]int, 200)
+	for i := range elements {
+		elements[i] = random.Intn(100)
+	}
+	expected := append([]int(nil), elements...)
+	sort.Ints(expected)
+
+	heap := NewMinMaxHeap[int](elements)
+	if !heap.IsValidHeap() {
+		t.Fatalf("Heap property violated: %v", heap.Data)
+	}
+
+	for heap.Len() > 0 {
+		var item int
+		if random.Intn(2) == 0 {
+			item, _ = heap.PopMin()
+			if item != expected[0] {
+				t.Fatalf("Expected minimum %v, but got %v", expected[0], item)
+			}
+			expected = expected[1:]
+		} else {
+			item, _ = heap.PopMax()
+			if item != expected[len(expected)-1] {
+				t.Fatalf("Expected maximum %v, but got %v", expected[len(expected)-1], item)
+			}
+			expected = expected[:len(expected)-1]
+		}
+		if !heap.IsValidHeap() {
+			t.Fatalf("Heap property violated after popping %v: %v", item, heap.Data)
+		}
+	}
+}
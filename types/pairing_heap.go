@@ -0,0 +1,210 @@
+package types
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// PairingNode is an element of a PairingHeap.  It is returned by Push and serves as the handle for DecreaseKey.
+type PairingNode[T any] struct {
+	value T
+	// child is the left-most child, sibling the next sibling to the right.  prev is the left sibling, or the parent
+	// for a left-most child, which allows a node to be cut from the tree in O(1)
+	child, sibling, prev *PairingNode[T]
+	// owner identifies the heap the node belongs to, and is nil once the node has been popped
+	owner *heapOwner
+}
+
+// Value returns the element held by the node.
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+// heapOwner identifies the mergeable heap a node belongs to.  Every PairingHeap and BinomialHeap has one, and each
+// node points to the owner of the heap it was pushed onto.  Meld forwards the owner of the emptied heap to the owner
+// of the heap it was melded into instead of visiting the moved nodes; the forwarding chains are shortened as they are
+// followed.
+type heapOwner struct {
+	next *heapOwner
+}
+
+// find returns the owner o has been forwarded to, which is the owner of the heap the node now belongs to.
+func (o *heapOwner) find() *heapOwner {
+	root := o
+	for root.next != nil {
+		root = root.next
+	}
+	for o != root {
+		next := o.next
+		o.next = root
+		o = next
+	}
+	return root
+}
+
+// PairingHeap is a mergeable heap represented as a single multi-way tree.  Push, Meld and DecreaseKey run in O(1)
+// and Pop in amortised O(log n).
+type PairingHeap[T any] struct {
+	root     *PairingNode[T]
+	size     int
+	heapType HeapType
+	compare  func(a, b T) bool
+	owner    *heapOwner
+}
+
+// NewPairingHeap creates a new, empty min or max pairing heap using the default comparator.
+func NewPairingHeap[T constraints.Ordered](heapType HeapType) *PairingHeap[T] {
+	return NewPairingHeapWithComparator[T](heapType, DefaultComparator[T]{})
+}
+
+// NewPairingHeapWithComparator creates a new, empty min or max pairing heap of any type using a custom comparator.
+func NewPairingHeapWithComparator[T any](heapType HeapType, comparator Comparator[T]) *PairingHeap[T] {
+	heap := &PairingHeap[T]{heapType: heapType, compare: comparator.LessThan, owner: &heapOwner{}}
+	if heapType == MaxHeap {
+		heap.compare = comparator.GreaterThan
+	}
+	return heap
+}
+
+// Push adds a new item to the heap and returns its node, which can be passed to DecreaseKey.
+func (h *PairingHeap[T]) Push(item T) *PairingNode[T] {
+	node := &PairingNode[T]{value: item, owner: h.owner}
+	h.root = h.link(h.root, node)
+	h.size++
+	return node
+}
+
+// Pop removes and returns the root element of the heap.  The boolean result is false if the heap is empty.
+func (h *PairingHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	root := h.root
+	h.root = h.mergePairs(root.child)
+	h.size--
+
+	root.child, root.owner = nil, nil
+	return root.value, true
+}
+
+// Peek returns the root element of the heap without removing it.  The boolean result is false if the heap is empty.
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+// Len returns the number of elements in the heap.
+func (h *PairingHeap[T]) Len() int {
+	return h.size
+}
+
+// Meld moves every element of other into h in O(1), leaving other empty.  Nodes returned by other.Push remain valid
+// handles into h.  It returns ErrHeapTypeMismatch if the heaps are not both min or both max heaps.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) error {
+	if h.heapType != other.heapType {
+		return ErrHeapTypeMismatch
+	}
+	if h == other {
+		return nil
+	}
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.root, other.size = nil, 0
+	other.owner.next, other.owner = h.owner, &heapOwner{}
+	return nil
+}
+
+// DecreaseKey replaces the value of node with value, which must not rank after the current value (it must be
+// smaller or equal for a MinHeap, larger or equal for a MaxHeap), and moves the node towards the root.
+// It returns ErrInvalidKey if the new value ranks after the current one, or ErrNodeNotInHeap if the node has
+// already been popped or belongs to another heap.
+func (h *PairingHeap[T]) DecreaseKey(node *PairingNode[T], value T) error {
+	if node.owner == nil || node.owner.find() != h.owner {
+		return ErrNodeNotInHeap
+	}
+	if h.compare(node.value, value) {
+		return ErrInvalidKey
+	}
+	node.value = value
+	if node == h.root {
+		return nil
+	}
+
+	// cut the node, along with its subtree, from its parent and link it back in at the root
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+	node.sibling, node.prev = nil, nil
+	h.root = h.link(h.root, node)
+	return nil
+}
+
+// link makes the root that ranks after the other the left-most child of the winner, and returns the winner.
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.compare(b.value, a.value) {
+		a, b = b, a
+	}
+	b.prev, b.sibling = a, a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// mergePairs combines a list of sibling subtrees into one tree using the standard two-pass strategy: link siblings
+// in pairs from left to right, then link the resulting trees from right to left.
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs []*PairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.prev, b.sibling = nil, nil
+		}
+		a.prev, a.sibling = nil, nil
+		pairs = append(pairs, h.link(a, b))
+	}
+
+	var root *PairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
+
+// IsValidHeap checks if the heap property is maintained for the entire heap.
+func (h *PairingHeap[T]) IsValidHeap() bool {
+	count := 0
+	var valid func(node *PairingNode[T]) bool
+	valid = func(node *PairingNode[T]) bool {
+		for child := node.child; child != nil; child = child.sibling {
+			count++
+			if h.compare(child.value, node.value) || !valid(child) {
+				return false
+			}
+		}
+		return true
+	}
+	if h.root == nil {
+		return h.size == 0
+	}
+	count++
+	return valid(h.root) && count == h.size
+}
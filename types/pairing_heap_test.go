@@ -0,0 +1,207 @@
+package types
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestPairingHeapPushAndPop(t *testing.T) {
+	heap := NewPairingHeap[int](MinHeap)
+	for _, item := range []int{5, 2, 9, 1, 5, 6} {
+		heap.Push(item)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated")
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		if !heap.IsValidHeap() {
+			t.Errorf("Heap property violated after popping %v", item)
+		}
+		popped = append(popped, item)
+	}
+
+	expected := []int{1, 2, 5, 5, 6, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestPairingHeapPopAndPeekEmpty(t *testing.T) {
+	heap := NewPairingHeap[int](MinHeap)
+	if _, ok := heap.Pop(); ok {
+		t.Errorf("Expected Pop on empty heap to return false")
+	}
+	if _, ok := heap.Peek(); ok {
+		t.Errorf("Expected Peek on empty heap to return false")
+	}
+}
+
+func TestPairingHeapWithComparator(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	heap := NewPairingHeapWithComparator[Person](MaxHeap, PersonComparator{})
+	heap.Push(bob)
+	heap.Push(charlie)
+	heap.Push(alice)
+
+	if item, _ := heap.Peek(); item != charlie {
+		t.Errorf("Expected %v, but got %v", charlie, item)
+	}
+}
+
+func TestPairingHeapMeld(t *testing.T) {
+	heap := NewPairingHeap[int](MinHeap)
+	other := NewPairingHeap[int](MinHeap)
+	for _, item := range []int{5, 9, 1} {
+		heap.Push(item)
+	}
+	node := other.Push(8)
+	other.Push(0)
+
+	if err := heap.Meld(other); err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+	if other.Len() != 0 {
+		t.Errorf("Expected melded heap to be empty, but got %d elements", other.Len())
+	}
+	// nodes of the melded heap remain valid handles
+	if err := heap.DecreaseKey(node, -1); err != nil {
+		t.Errorf("Unexpected error decreasing key: %v", err)
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		popped = append(popped, item)
+	}
+
+	expected := []int{-1, 0, 1, 5, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestPairingHeapMeldHeapTypeMismatch(t *testing.T) {
+	heap := NewPairingHeap[int](MinHeap)
+	other := NewPairingHeap[int](MaxHeap)
+	other.Push(1)
+
+	if err := heap.Meld(other); !errors.Is(err, ErrHeapTypeMismatch) {
+		t.Errorf("Expected %v, but got %v", ErrHeapTypeMismatch, err)
+	}
+	if other.Len() != 1 {
+		t.Errorf("Expected failed meld to leave the other heap untouched")
+	}
+}
+
+func TestPairingHeapDecreaseKey(t *testing.T) {
+	heap := NewPairingHeap[int](MaxHeap)
+	heap.Push(10)
+	node := heap.Push(3)
+	heap.Push(7)
+
+	if err := heap.DecreaseKey(node, 2); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Expected %v, but got %v", ErrInvalidKey, err)
+	}
+	if err := heap.DecreaseKey(node, 20); err != nil {
+		t.Errorf("Unexpected error decreasing key: %v", err)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated after decreasing key")
+	}
+
+	if item, _ := heap.Pop(); item != 20 || node.Value() != 20 {
+		t.Errorf("Expected 20, but got %v", item)
+	}
+	if err := heap.DecreaseKey(node, 30); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+}
+
+func TestPairingHeapDecreaseKeyRejectsForeignNodes(t *testing.T) {
+	heap := NewPairingHeap[int](MinHeap)
+	other := NewPairingHeap[int](MinHeap)
+	heap.Push(5)
+	foreign := other.Push(8)
+	other.Push(3)
+
+	if err := heap.DecreaseKey(foreign, 1); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+	if !heap.IsValidHeap() || !other.IsValidHeap() || foreign.Value() != 8 {
+		t.Errorf("Expected a rejected DecreaseKey to leave both heaps untouched")
+	}
+
+	// nodes follow their elements through a chain of melds, and an emptied heap's new nodes are its own
+	third := NewPairingHeap[int](MinHeap)
+	if err := third.Meld(other); err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+	if err := heap.Meld(third); err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+	if err := heap.DecreaseKey(foreign, 1); err != nil {
+		t.Errorf("Unexpected error decreasing key of a melded node: %v", err)
+	}
+	if err := third.DecreaseKey(foreign, 0); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+	fresh := other.Push(9)
+	if err := heap.DecreaseKey(fresh, 0); !errors.Is(err, ErrNodeNotInHeap) {
+		t.Errorf("Expected %v, but got %v", ErrNodeNotInHeap, err)
+	}
+	if item, _ := heap.Pop(); item != 1 || !heap.IsValidHeap() || !other.IsValidHeap() {
+		t.Errorf("Expected 1 from a valid heap, but got %v", item)
+	}
+}
+
+func TestPairingHeapRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	heap := NewPairingHeap[int](MinHeap)
+	other := NewPairingHeap[int](MinHeap)
+	var nodes []*PairingNode[int]
+	var expected []int
+
+	for i := 0; i < 500; i++ {
+		item := random.Intn(1000)
+		if i%2 == 0 {
+			nodes = append(nodes, heap.Push(item))
+		} else {
+			nodes = append(nodes, other.Push(item))
+		}
+	}
+	_ = heap.Meld(other)
+	for _, node := range nodes {
+		value := node.Value()
+		if random.Intn(2) == 0 {
+			value -= random.Intn(500)
+			if err := heap.DecreaseKey(node, value); err != nil {
+				t.Fatalf("Unexpected error decreasing key: %v", err)
+			}
+		}
+		expected = append(expected, value)
+	}
+	if !heap.IsValidHeap() {
+		t.Fatalf("Heap property violated")
+	}
+
+	var popped []int
+	for heap.Len() > 0 {
+		item, _ := heap.Pop()
+		popped = append(popped, item)
+	}
+
+	sort.Ints(expected)
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
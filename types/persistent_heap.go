@@ -0,0 +1,127 @@
+package types
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// leftistNode is an immutable node of a leftist tree.  rank is the length of the node's right spine, which a
+// leftist tree keeps no longer than its left one.
+type leftistNode[T any] struct {
+	value       T
+	rank        int
+	left, right *leftistNode[T]
+}
+
+// PersistentHeap is an immutable priority queue implemented as a leftist heap.  Push, Pop and Meld return a new
+// version of the heap in O(log n) and leave the receiver untouched; versions share all nodes off the merge path, so
+// keeping old versions around as snapshots is cheap.  The zero value is not usable; create heaps with
+// NewPersistentHeap or NewPersistentHeapWithComparator.
+type PersistentHeap[T any] struct {
+	root     *leftistNode[T]
+	size     int
+	heapType HeapType
+	compare  func(a, b T) bool
+}
+
+// NewPersistentHeap creates a new, empty min or max persistent heap using the default comparator.
+func NewPersistentHeap[T constraints.Ordered](heapType HeapType) *PersistentHeap[T] {
+	return NewPersistentHeapWithComparator[T](heapType, DefaultComparator[T]{})
+}
+
+// NewPersistentHeapWithComparator creates a new, empty min or max persistent heap of any type using a custom
+// comparator.
+func NewPersistentHeapWithComparator[T any](heapType HeapType, comparator Comparator[T]) *PersistentHeap[T] {
+	return &PersistentHeap[T]{heapType: heapType, compare: comparison(heapType, comparator)}
+}
+
+// Push returns a new version of the heap with item added.
+func (h *PersistentHeap[T]) Push(item T) *PersistentHeap[T] {
+	return h.with(h.merge(h.root, &leftistNode[T]{value: item, rank: 1}), h.size+1)
+}
+
+// Pop returns the root element of the heap along with a new version of the heap without it.
+// The boolean result is false, and the heap returned is h itself, if the heap is empty.
+func (h *PersistentHeap[T]) Pop() (T, *PersistentHeap[T], bool) {
+	if h.root == nil {
+		var zero T
+		return zero, h, false
+	}
+	return h.root.value, h.with(h.merge(h.root.left, h.root.right), h.size-1), true
+}
+
+// Peek returns the root element of the heap.  The boolean result is false if the heap is empty.
+func (h *PersistentHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+// Len returns the number of elements in the heap.
+func (h *PersistentHeap[T]) Len() int {
+	return h.size
+}
+
+// Meld returns a new heap holding the elements of both h and other, leaving both untouched.  It returns
+// ErrHeapTypeMismatch if the heaps are not both min or both max heaps.
+func (h *PersistentHeap[T]) Meld(other *PersistentHeap[T]) (*PersistentHeap[T], error) {
+	if h.heapType != other.heapType {
+		return nil, ErrHeapTypeMismatch
+	}
+	return h.with(h.merge(h.root, other.root), h.size+other.size), nil
+}
+
+// with returns a new version of the heap sharing h's configuration.
+func (h *PersistentHeap[T]) with(root *leftistNode[T], size int) *PersistentHeap[T] {
+	return &PersistentHeap[T]{root: root, size: size, heapType: h.heapType, compare: h.compare}
+}
+
+// merge combines two leftist trees along their right spines.  Only nodes on the merge path are copied; every other
+// subtree is shared with the inputs.
+func (h *PersistentHeap[T]) merge(a, b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.compare(b.value, a.value) {
+		a, b = b, a
+	}
+
+	left, right := a.left, h.merge(a.right, b)
+	// keep the shorter spine on the right
+	if leftistRank(left) < leftistRank(right) {
+		left, right = right, left
+	}
+	return &leftistNode[T]{value: a.value, rank: leftistRank(right) + 1, left: left, right: right}
+}
+
+// leftistRank returns the rank of a leftist tree, zero for the empty tree.
+func leftistRank[T any](node *leftistNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.rank
+}
+
+// IsValidHeap checks if the heap property and the leftist property are maintained for the entire heap.
+func (h *PersistentHeap[T]) IsValidHeap() bool {
+	count := 0
+	var valid func(node *leftistNode[T]) bool
+	valid = func(node *leftistNode[T]) bool {
+		if node == nil {
+			return true
+		}
+		count++
+		for _, child := range []*leftistNode[T]{node.left, node.right} {
+			if child != nil && h.compare(child.value, node.value) {
+				return false
+			}
+		}
+		return leftistRank(node.left) >= leftistRank(node.right) && node.rank == leftistRank(node.right)+1 &&
+			valid(node.left) && valid(node.right)
+	}
+	return valid(h.root) && count == h.size
+}
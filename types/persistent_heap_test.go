@@ -0,0 +1,137 @@
+package types
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// drainPersistent pops every element of a persistent heap, leaving the heap itself untouched.
+func drainPersistent[T any](heap *PersistentHeap[T]) []T {
+	var items []T
+	for item, rest, ok := heap.Pop(); ok; item, rest, ok = rest.Pop() {
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestPersistentHeapPushAndPop(t *testing.T) {
+	heap := NewPersistentHeap[int](MinHeap)
+	for _, item := range []int{5, 2, 9, 1, 5, 6} {
+		heap = heap.Push(item)
+	}
+	if !heap.IsValidHeap() {
+		t.Errorf("Heap property violated")
+	}
+
+	expected := []int{1, 2, 5, 5, 6, 9}
+	if items := drainPersistent(heap); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+	if heap.Len() != 6 {
+		t.Errorf("Expected draining to leave all 6 elements, but got %d", heap.Len())
+	}
+}
+
+func TestPersistentHeapEmpty(t *testing.T) {
+	heap := NewPersistentHeap[int](MaxHeap)
+	if _, ok := heap.Peek(); ok {
+		t.Errorf("Expected Peek on empty heap to return false")
+	}
+	if _, rest, ok := heap.Pop(); ok || rest != heap {
+		t.Errorf("Expected Pop on empty heap to return false and the same heap")
+	}
+}
+
+func TestPersistentHeapVersionsAreIndependent(t *testing.T) {
+	v1 := NewPersistentHeap[int](MaxHeap).Push(3).Push(7)
+	v2 := v1.Push(10)
+	top, v3, _ := v2.Pop()
+	_, v4, _ := v3.Pop()
+	v5 := v1.Push(4)
+
+	if top != 10 {
+		t.Errorf("Expected 10, but got %v", top)
+	}
+	tests := []struct {
+		heap     *PersistentHeap[int]
+		expected []int
+	}{
+		{v1, []int{7, 3}},
+		{v2, []int{10, 7, 3}},
+		{v3, []int{7, 3}},
+		{v4, []int{3}},
+		{v5, []int{7, 4, 3}},
+	}
+	for i, tt := range tests {
+		if items := drainPersistent(tt.heap); !reflect.DeepEqual(items, tt.expected) {
+			t.Errorf("version %d: Expected %v, but got %v", i+1, tt.expected, items)
+		}
+	}
+}
+
+func TestPersistentHeapSharesStructure(t *testing.T) {
+	heap := NewPersistentHeap[int](MinHeap)
+	for i := 0; i < 100; i++ {
+		heap = heap.Push(i)
+	}
+	pushed := heap.Push(1000)
+
+	// pushing an element that ranks last only copies the right spine, so the root's left subtree is shared
+	if pushed.root.left != heap.root.left {
+		t.Errorf("Expected the left subtree of the root to be shared between versions")
+	}
+}
+
+func TestPersistentHeapMeld(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	left := NewPersistentHeapWithComparator[Person](MinHeap, PersonComparator{}).Push(charlie)
+	right := NewPersistentHeapWithComparator[Person](MinHeap, PersonComparator{}).Push(alice).Push(bob)
+
+	melded, err := left.Meld(right)
+	if err != nil {
+		t.Fatalf("Unexpected error melding: %v", err)
+	}
+
+	expected := []Person{bob, alice, charlie}
+	if items := drainPersistent(melded); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+	if left.Len() != 1 || right.Len() != 2 {
+		t.Errorf("Expected Meld to leave both inputs untouched")
+	}
+
+	if _, err := left.Meld(NewPersistentHeapWithComparator[Person](MaxHeap, PersonComparator{})); !errors.Is(err, ErrHeapTypeMismatch) {
+		t.Errorf("Expected %v, but got %v", ErrHeapTypeMismatch, err)
+	}
+}
+
+func TestPersistentHeapRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	heap := NewPersistentHeap[int](MinHeap)
+	var expected []int
+	for i := 0; i < 500; i++ {
+		item := random.Intn(1000)
+		heap = heap.Push(item)
+		expected = append(expected, item)
+		if random.Intn(3) == 0 {
+			_, heap, _ = heap.Pop()
+			sort.Ints(expected)
+			expected = expected[1:]
+		}
+	}
+	if !heap.IsValidHeap() {
+		t.Fatalf("Heap property violated")
+	}
+
+	sort.Ints(expected)
+	if items := drainPersistent(heap); !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, but got %v", expected, items)
+	}
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"errors"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrNonMonotonicKey is returned when pushing a key smaller than the last key popped from a RadixHeap.
+var ErrNonMonotonicKey = errors.New("types: key is less than the last popped key")
+
+// radixEntry pairs a key with its payload.
+type radixEntry[K constraints.Unsigned, V any] struct {
+	key   K
+	value V
+}
+
+// RadixHeap is a monotone min priority queue for unsigned integer keys with attached payloads, as used by Dijkstra's
+// algorithm on non-negative integer weights.  Keys pushed must never be smaller than the last key popped.  In
+// exchange elements are bucketed by the highest bit in which they differ from the last popped key, and each element
+// is moved between buckets at most once per bit, giving amortised O(log C) operations, where C is the key range,
+// without any comparisons between elements.
+type RadixHeap[K constraints.Unsigned, V any] struct {
+	// buckets[0] holds keys equal to last; buckets[i] holds keys whose highest bit differing from last is bit i-1
+	buckets [65][]radixEntry[K, V]
+	last    K
+	size    int
+}
+
+// NewRadixHeap creates a new, empty radix heap.
+func NewRadixHeap[K constraints.Unsigned, V any]() *RadixHeap[K, V] {
+	return &RadixHeap[K, V]{}
+}
+
+// Push adds value with the given key to the heap.  It returns ErrNonMonotonicKey, leaving the heap unchanged, if key
+// is less than the last key popped.
+func (h *RadixHeap[K, V]) Push(key K, value V) error {
+	if key < h.last {
+		return ErrNonMonotonicKey
+	}
+	i := h.bucket(key)
+	h.buckets[i] = append(h.buckets[i], radixEntry[K, V]{key: key, value: value})
+	h.size++
+	return nil
+}
+
+// Pop removes and returns the element with the smallest key.  The boolean result is false if the heap is empty.
+func (h *RadixHeap[K, V]) Pop() (K, V, bool) {
+	if !h.refill() {
+		var value V
+		return 0, value, false
+	}
+	last := len(h.buckets[0]) - 1
+	entry := h.buckets[0][last]
+	h.buckets[0] = h.buckets[0][:last]
+	h.size--
+	return entry.key, entry.value, true
+}
+
+// Peek returns the element with the smallest key without removing it.  The boolean result is false if the heap is
+// empty.  Unlike Pop it does not redistribute any bucket, so it leaves the last popped key, and therefore the keys
+// Push accepts, unchanged.
+func (h *RadixHeap[K, V]) Peek() (K, V, bool) {
+	if h.size == 0 {
+		var value V
+		return 0, value, false
+	}
+	if n := len(h.buckets[0]); n > 0 {
+		entry := h.buckets[0][n-1]
+		return entry.key, entry.value, true
+	}
+
+	// every key in a lower bucket is smaller, so the minimum is in the first non-empty one; of equal keys, Pop
+	// returns the last one found here
+	i := 1
+	for len(h.buckets[i]) == 0 {
+		i++
+	}
+	entries := h.buckets[i]
+	minimum := entries[0]
+	for _, entry := range entries[1:] {
+		if entry.key <= minimum.key {
+			minimum = entry
+		}
+	}
+	return minimum.key, minimum.value, true
+}
+
+// Len returns the number of elements in the heap.
+func (h *RadixHeap[K, V]) Len() int {
+	return h.size
+}
+
+// refill makes sure buckets[0] holds the smallest keys, if there are any elements at all.  When it is empty, the
+// first non-empty bucket is redistributed around its own minimum, which becomes the new last key; every element of
+// that bucket then lands in a strictly lower bucket, and the minimum itself in buckets[0].
+func (h *RadixHeap[K, V]) refill() bool {
+	if h.size == 0 {
+		return false
+	}
+	if len(h.buckets[0]) > 0 {
+		return true
+	}
+
+	i := 1
+	for len(h.buckets[i]) == 0 {
+		i++
+	}
+	entries := h.buckets[i]
+	h.last = entries[0].key
+	for _, entry := range entries[1:] {
+		h.last = min(h.last, entry.key)
+	}
+	for _, entry := range entries {
+		j := h.bucket(entry.key)
+		h.buckets[j] = append(h.buckets[j], entry)
+	}
+	h.buckets[i] = entries[:0]
+	return true
+}
+
+// bucket returns the index of the bucket key belongs in relative to the last popped key.
+func (h *RadixHeap[K, V]) bucket(key K) int {
+	return bits.Len64(uint64(key ^ h.last))
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRadixHeapPushAndPop(t *testing.T) {
+	heap := NewRadixHeap[uint, string]()
+	for _, key := range []uint{5, 2, 9, 1, 5, 6} {
+		if err := heap.Push(key, "v"); err != nil {
+			t.Fatalf("Unexpected error pushing %v: %v", key, err)
+		}
+	}
+
+	var popped []uint
+	for heap.Len() > 0 {
+		key, _, _ := heap.Pop()
+		popped = append(popped, key)
+	}
+
+	expected := []uint{1, 2, 5, 5, 6, 9}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestRadixHeapPayloads(t *testing.T) {
+	heap := NewRadixHeap[uint8, string]()
+	_ = heap.Push(200, "far")
+	_ = heap.Push(3, "near")
+
+	if key, value, ok := heap.Peek(); !ok || key != 3 || value != "near" {
+		t.Errorf("Expected (3, near, true), but got (%v, %v, %v)", key, value, ok)
+	}
+	heap.Pop()
+	if key, value, ok := heap.Pop(); !ok || key != 200 || value != "far" {
+		t.Errorf("Expected (200, far, true), but got (%v, %v, %v)", key, value, ok)
+	}
+	if _, _, ok := heap.Pop(); ok {
+		t.Errorf("Expected Pop on empty heap to return false")
+	}
+	if _, _, ok := heap.Peek(); ok {
+		t.Errorf("Expected Peek on empty heap to return false")
+	}
+}
+
+func TestRadixHeapRejectsNonMonotonicKeys(t *testing.T) {
+	heap := NewRadixHeap[uint, int]()
+	_ = heap.Push(10, 0)
+	_ = heap.Push(20, 0)
+	heap.Pop()
+
+	if err := heap.Push(9, 0); !errors.Is(err, ErrNonMonotonicKey) {
+		t.Errorf("Expected %v, but got %v", ErrNonMonotonicKey, err)
+	}
+	// keys equal to the last popped key are still allowed
+	if err := heap.Push(10, 0); err != nil {
+		t.Errorf("Unexpected error pushing the last popped key: %v", err)
+	}
+	if heap.Len() != 2 {
+		t.Errorf("Expected 2 elements, but got %d", heap.Len())
+	}
+}
+
+func TestRadixHeapPushAfterPeek(t *testing.T) {
+	heap := NewRadixHeap[uint, string]()
+	_ = heap.Push(10, "ten")
+	_ = heap.Push(20, "twenty")
+	heap.Peek()
+
+	// nothing has been popped yet, so any key is still allowed
+	if err := heap.Push(5, "five"); err != nil {
+		t.Fatalf("Unexpected error pushing after Peek: %v", err)
+	}
+	if key, value, ok := heap.Peek(); !ok || key != 5 || value != "five" {
+		t.Errorf("Expected (5, five, true), but got (%v, %v, %v)", key, value, ok)
+	}
+
+	var popped []uint
+	for heap.Len() > 0 {
+		key, _, _ := heap.Pop()
+		popped = append(popped, key)
+	}
+	expected := []uint{5, 10, 20}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestRadixHeapExtremeKeys(t *testing.T) {
+	heap := NewRadixHeap[uint64, int]()
+	for _, key := range []uint64{math.MaxUint64, 0, 1 << 63, math.MaxUint64 - 1} {
+		_ = heap.Push(key, 0)
+	}
+
+	var popped []uint64
+	for heap.Len() > 0 {
+		key, _, _ := heap.Pop()
+		popped = append(popped, key)
+	}
+
+	expected := []uint64{0, 1 << 63, math.MaxUint64 - 1, math.MaxUint64}
+	if !reflect.DeepEqual(popped, expected) {
+		t.Errorf("Expected %v, but got %v", expected, popped)
+	}
+}
+
+func TestRadixHeapMonotoneWorkload(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	heap := NewRadixHeap[uint32, int]()
+	var pending []uint32
+	var last uint32
+	for i := 0; i < 2000; i++ {
+		key := last + uint32(random.Intn(1000))
+		_ = heap.Push(key, i)
+		pending = append(pending, key)
+		if random.Intn(2) == 0 {
+			sort.Slice(pending, func(a, b int) bool { return pending[a] < pending[b] })
+			peeked, peekedValue, _ := heap.Peek()
+			popped, value, _ := heap.Pop()
+			if peeked != popped || peekedValue != value {
+				t.Fatalf("Expected Peek to return (%v, %v), but got (%v, %v)", popped, value, peeked, peekedValue)
+			}
+			if popped != pending[0] {
+				t.Fatalf("Expected %v, but got %v", pending[0], popped)
+			}
+			last, pending = popped, pending[1:]
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// StringComparator compares strings for human-facing listings.  The zero value compares strings rune by rune, which
+// for valid UTF-8 is the same order as DefaultComparator[string]; each option relaxes that order:
+//
+//   - Natural compares runs of ASCII digits by their numeric value, so "file2" sorts before "file10".  Leading zeros
+//     are ignored, so "file02" and "file2" are equal.
+//   - IgnoreCase compares runes after Unicode simple case folding, so "apple" sorts before "Zebra" and "GO" equals "go".
+//   - IgnoreAccents compares accented Latin letters (Latin-1 Supplement and Latin Extended-A) as their base letter and
+//     skips combining diacritical marks, so "café" equals "cafe".
+//
+// Options can be combined, e.g. StringComparator{Natural: true, IgnoreCase: true} for file listings.
+type StringComparator struct {
+	Natural       bool
+	IgnoreCase    bool
+	IgnoreAccents bool
+}
+
+func (s StringComparator) GreaterThan(a, b string) bool { return s.Compare(a, b) > 0 }
+func (s StringComparator) LessThan(a, b string) bool    { return s.Compare(a, b) < 0 }
+func (s StringComparator) EqualTo(a, b string) bool     { return s.Compare(a, b) == 0 }
+
+// Compare returns a negative number, zero or a positive number when a is less than, equal to or greater than b.
+func (s StringComparator) Compare(a, b string) int {
+	i, j := 0, 0
+	for {
+		// skip ignorable runes first, so that they do not affect the end-of-string checks below
+		i, j = s.skipIgnorable(a, i), s.skipIgnorable(b, j)
+		if i == len(a) || j == len(b) {
+			return (len(a) - i) - (len(b) - j)
+		}
+
+		if s.Natural && isDigit(a[i]) && isDigit(b[j]) {
+			var result int
+			result, i, j = compareNumbers(a, i, b, j)
+			if result != 0 {
+				return result
+			}
+			continue
+		}
+
+		ra, sizeA := utf8.DecodeRuneInString(a[i:])
+		rb, sizeB := utf8.DecodeRuneInString(b[j:])
+		if ra, rb = s.fold(ra), s.fold(rb); ra != rb {
+			if ra < rb {
+				return -1
+			}
+			return 1
+		}
+		i, j = i+sizeA, j+sizeB
+	}
+}
+
+// skipIgnorable returns the index of the first rune at or after i that takes part in comparisons.
+func (s StringComparator) skipIgnorable(str string, i int) int {
+	if !s.IgnoreAccents {
+		return i
+	}
+	for i < len(str) {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if !unicode.Is(unicode.Mn, r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// fold maps r to the rune it is compared as.
+func (s StringComparator) fold(r rune) rune {
+	if s.IgnoreAccents {
+		if base, ok := accentFolds[r]; ok {
+			r = base
+		}
+	}
+	if s.IgnoreCase {
+		r = foldCase(r)
+	}
+	return r
+}
+
+// foldCase returns the smallest rune in the case folding orbit of r, so that all case variants of a letter compare
+// equal.
+func foldCase(r rune) rune {
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < smallest {
+			smallest = f
+		}
+	}
+	return smallest
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// compareNumbers compares the runs of digits starting at a[i] and b[j] by their numeric value, and returns the result
+// along with the indices just after each run.
+func compareNumbers(a string, i int, b string, j int) (int, int, int) {
+	for i < len(a) && a[i] == '0' {
+		i++
+	}
+	for j < len(b) && b[j] == '0' {
+		j++
+	}
+	startA, startB := i, j
+	for i < len(a) && isDigit(a[i]) {
+		i++
+	}
+	for j < len(b) && isDigit(b[j]) {
+		j++
+	}
+
+	// without leading zeros, a longer number is a larger one; numbers of the same length compare like strings
+	numberA, numberB := a[startA:i], b[startB:j]
+	switch {
+	case len(numberA) != len(numberB):
+		return len(numberA) - len(numberB), i, j
+	case numberA < numberB:
+		return -1, i, j
+	case numberA > numberB:
+		return 1, i, j
+	default:
+		return 0, i, j
+	}
+}
+
+// accentFolds maps accented letters of the Latin-1 Supplement and Latin Extended-A blocks to their base letter.
+var accentFolds = func() map[rune]rune {
+	bases := map[rune]string{
+		'A': "ÀÁÂÃÄÅĀĂĄ", 'a': "àáâãäåāăą",
+		'C': "ÇĆĈĊČ", 'c': "çćĉċč",
+		'D': "ÐĎĐ", 'd': "ðďđ",
+		'E': "ÈÉÊËĒĔĖĘĚ", 'e': "èéêëēĕėęě",
+		'G': "ĜĞĠĢ", 'g': "ĝğġģ",
+		'H': "ĤĦ", 'h': "ĥħ",
+		'I': "ÌÍÎÏĨĪĬĮİ", 'i': "ìíîïĩīĭįı",
+		'J': "Ĵ", 'j': "ĵ",
+		'K': "Ķ", 'k': "ķ",
+		'L': "ĹĻĽĿŁ", 'l': "ĺļľŀł",
+		'N': "ÑŃŅŇ", 'n': "ñńņň",
+		'O': "ÒÓÔÕÖØŌŎŐ", 'o': "òóôõöøōŏő",
+		'R': "ŔŖŘ", 'r': "ŕŗř",
+		'S': "ŚŜŞŠ", 's': "śŝşš",
+		'T': "ŢŤŦ", 't': "ţťŧ",
+		'U': "ÙÚÛÜŨŪŬŮŰŲ", 'u': "ùúûüũūŭůűų",
+		'W': "Ŵ", 'w': "ŵ",
+		'Y': "ÝŶŸ", 'y': "ýÿŷ",
+		'Z': "ŹŻŽ", 'z': "źżž",
+	}
+	folds := make(map[rune]rune)
+	for base, accented := range bases {
+		for _, r := range accented {
+			folds[r] = base
+		}
+	}
+	return folds
+}()
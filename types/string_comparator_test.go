@@ -0,0 +1,77 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringComparatorZeroValueMatchesDefault(t *testing.T) {
+	data := []string{"file10", "Zebra", "file2", "apple", "", "éclair", "eclair"}
+
+	got := sortWith(data, StringComparator{})
+	expected := sortWith(data, Comparator[string](DefaultComparator[string]{}))
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestStringComparatorNatural(t *testing.T) {
+	comparator := StringComparator{Natural: true}
+
+	got := sortWith([]string{"file10", "file2", "file1", "file10a", "file", "file02b", "img12", "img2x3"}, comparator)
+	expected := []string{"file", "file1", "file2", "file02b", "file10", "file10a", "img2x3", "img12"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if !comparator.EqualTo("v007", "v7") {
+		t.Errorf("Expected leading zeros to be ignored")
+	}
+	if !comparator.LessThan("99999999999999999999998", "99999999999999999999999") {
+		t.Errorf("Expected numbers wider than 64 bits to compare by value")
+	}
+}
+
+func TestStringComparatorIgnoreCase(t *testing.T) {
+	comparator := StringComparator{IgnoreCase: true}
+
+	got := sortWith([]string{"Zebra", "apple", "Mango", "banana"}, comparator)
+	expected := []string{"apple", "banana", "Mango", "Zebra"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	for _, pair := range [][2]string{{"GO", "go"}, {"ΣΊΣΥΦΟΣ", "σίσυφος"}, {"Straße", "STRAßE"}} {
+		if !comparator.EqualTo(pair[0], pair[1]) {
+			t.Errorf("Expected %q to equal %q", pair[0], pair[1])
+		}
+	}
+	if comparator.EqualTo("go", "gopher") {
+		t.Errorf("Expected a prefix to sort before the longer string")
+	}
+}
+
+func TestStringComparatorIgnoreAccents(t *testing.T) {
+	comparator := StringComparator{IgnoreAccents: true}
+
+	got := sortWith([]string{"éclair", "ezine", "eclat", "Ångström"}, comparator)
+	expected := []string{"Ångström", "éclair", "eclat", "ezine"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	// precomposed and decomposed (e + combining acute accent) spellings
+	if !comparator.EqualTo("café", "café") || !comparator.EqualTo("café", "cafe") {
+		t.Errorf("Expected accented letters to equal their base letter")
+	}
+	if comparator.EqualTo("Café", "cafe") {
+		t.Errorf("Expected IgnoreAccents alone to keep case significant")
+	}
+}
+
+func TestStringComparatorCombinedOptions(t *testing.T) {
+	comparator := StringComparator{Natural: true, IgnoreCase: true, IgnoreAccents: true}
+
+	got := sortWith([]string{"Résumé 10", "resume 9", "RESUME 1", "Rèsumé 2"}, comparator)
+	expected := []string{"RESUME 1", "Rèsumé 2", "resume 9", "Résumé 10"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
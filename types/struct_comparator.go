@@ -0,0 +1,182 @@
+package types
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidSortSpec is returned, wrapped with details, when a sort spec is malformed or names an unknown field.
+	ErrInvalidSortSpec = errors.New("types: invalid sort spec")
+	// ErrUnsupportedSortField is returned, wrapped with details, when a sort spec names a field that cannot be compared.
+	ErrUnsupportedSortField = errors.New("types: unsupported sort field type")
+)
+
+// sortTag is the struct tag holding a sort spec, placed on a blank field of the struct being sorted.
+const sortTag = "sort"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// NewStructComparator derives a comparator for the struct type T from the sort spec in the `sort` tag of one of its
+// blank fields, e.g.
+//
+//	type Employee struct {
+//		_    struct{} `sort:"dob,desc;name"`
+//		Name string
+//		Dob  time.Time
+//	}
+//
+// See ParseStructComparator for the spec format.
+func NewStructComparator[T any]() (Comparator[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v is not a struct", ErrInvalidSortSpec, t)
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if spec, ok := field.Tag.Lookup(sortTag); ok && field.Name == "_" {
+			return ParseStructComparator[T](spec)
+		}
+	}
+	return nil, fmt.Errorf("%w: %v has no blank field with a %q tag", ErrInvalidSortSpec, t, sortTag)
+}
+
+// ParseStructComparator derives a comparator for the struct type T from a sort spec, without requiring a tag on T.
+// The spec is a semicolon separated list of field names, matched case-insensitively and including fields promoted
+// from embedded structs, each optionally followed by ",asc" (the default) or ",desc".  Elements are ordered by the
+// first field, with ties broken by the following ones.  Fields must be exported and hold an integer, float, string,
+// bool or time.Time; anything else is rejected with ErrUnsupportedSortField.
+func ParseStructComparator[T any](spec string) (Comparator[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v is not a struct", ErrInvalidSortSpec, t)
+	}
+	if strings.TrimSpace(spec) == "" {
+		return nil, fmt.Errorf("%w: empty spec for %v", ErrInvalidSortSpec, t)
+	}
+
+	var keys []sortKey
+	for _, term := range strings.Split(spec, ";") {
+		key, err := parseSortKey(t, term)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return structComparator[T]{keys: keys}, nil
+}
+
+// sortKey is a single field of a sort spec, resolved against the struct type.
+type sortKey struct {
+	index      []int
+	descending bool
+	compare    func(a, b reflect.Value) int
+}
+
+func parseSortKey(t reflect.Type, term string) (sortKey, error) {
+	name, direction, _ := strings.Cut(term, ",")
+	name, direction = strings.TrimSpace(name), strings.TrimSpace(direction)
+	if name == "" {
+		return sortKey{}, fmt.Errorf("%w: missing field name in %q", ErrInvalidSortSpec, term)
+	}
+
+	var key sortKey
+	switch strings.ToLower(direction) {
+	case "", "asc":
+	case "desc":
+		key.descending = true
+	default:
+		return sortKey{}, fmt.Errorf("%w: unknown direction %q for field %q", ErrInvalidSortSpec, direction, name)
+	}
+
+	// an exact match wins over fields whose names only differ in case, which FieldByNameFunc reports as not found
+	field, ok := t.FieldByName(name)
+	if !ok {
+		field, ok = t.FieldByNameFunc(func(fieldName string) bool { return strings.EqualFold(fieldName, name) })
+	}
+	if !ok {
+		if matches := fieldsNamedFold(t, name); len(matches) > 1 {
+			return sortKey{}, fmt.Errorf("%w: field name %q is ambiguous in %v, matching %s", ErrInvalidSortSpec, name,
+				t, strings.Join(matches, ", "))
+		}
+		return sortKey{}, fmt.Errorf("%w: %v has no field %q", ErrInvalidSortSpec, t, name)
+	}
+	// every struct on the path to a promoted field must be reachable without dereferencing a possibly nil pointer
+	// and, like the field itself, must be exported for reflection to read it
+	for i := range field.Index {
+		step := t.FieldByIndex(field.Index[:i+1])
+		if !step.IsExported() {
+			return sortKey{}, fmt.Errorf("%w: field %v.%s is not exported", ErrUnsupportedSortField, t, step.Name)
+		}
+		if i < len(field.Index)-1 && step.Type.Kind() == reflect.Pointer {
+			return sortKey{}, fmt.Errorf("%w: field %v.%s is promoted through pointer %s", ErrUnsupportedSortField,
+				t, field.Name, step.Name)
+		}
+	}
+	key.index = field.Index
+	key.compare = valueComparison(field.Type)
+	if key.compare == nil {
+		return sortKey{}, fmt.Errorf("%w: field %v.%s has type %v", ErrUnsupportedSortField, t, field.Name, field.Type)
+	}
+	return key, nil
+}
+
+// fieldsNamedFold returns the names of the fields of t, including promoted ones, that equal name ignoring case.
+func fieldsNamedFold(t reflect.Type, name string) []string {
+	var matches []string
+	for _, field := range reflect.VisibleFields(t) {
+		if strings.EqualFold(field.Name, name) {
+			matches = append(matches, field.Name)
+		}
+	}
+	return matches
+}
+
+// valueComparison returns a three-way comparison for values of type t, or nil if t is not supported.
+func valueComparison(t reflect.Type) func(a, b reflect.Value) int {
+	if t == timeType {
+		return func(a, b reflect.Value) int {
+			return a.Interface().(time.Time).Compare(b.Interface().(time.Time))
+		}
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.Int(), b.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.Uint(), b.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) int { return cmp.Compare(a.Float(), b.Float()) }
+	case reflect.String:
+		return func(a, b reflect.Value) int { return strings.Compare(a.String(), b.String()) }
+	case reflect.Bool:
+		return func(a, b reflect.Value) int { return boolCompare(a.Bool(), b.Bool()) }
+	default:
+		return nil
+	}
+}
+
+// structComparator compares structs field by field according to a parsed sort spec.
+type structComparator[T any] struct {
+	keys []sortKey
+}
+
+func (s structComparator[T]) GreaterThan(a, b T) bool { return s.Compare(a, b) > 0 }
+func (s structComparator[T]) LessThan(a, b T) bool    { return s.Compare(a, b) < 0 }
+func (s structComparator[T]) EqualTo(a, b T) bool     { return s.Compare(a, b) == 0 }
+func (s structComparator[T]) Compare(a, b T) int {
+	va, vb := reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem()
+	for _, key := range s.keys {
+		result := key.compare(va.FieldByIndex(key.index), vb.FieldByIndex(key.index))
+		if key.descending {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+	return 0
+}
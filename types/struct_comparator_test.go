@@ -0,0 +1,154 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type employee struct {
+	_      struct{} `sort:"dob,desc;name"`
+	Name   string
+	Dob    time.Time
+	Salary float64
+	Level  uint8
+	Active bool
+}
+
+type unsupportedRecord struct {
+	Tags []string
+	note string
+}
+
+type Audit struct {
+	Created time.Time
+}
+
+type document struct {
+	Audit
+	Title string
+}
+
+type auditInfo struct {
+	Created time.Time
+}
+
+type draft struct {
+	auditInfo
+}
+
+type Entity struct {
+	ID int
+}
+
+type entityRef struct {
+	*Entity
+}
+
+type shoutingRecord struct {
+	Name string
+	NAME string
+}
+
+func TestNewStructComparator(t *testing.T) {
+	bob := employee{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	erin := employee{Name: "Erin", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := employee{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	dave := employee{Name: "Dave", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+
+	comparator, err := NewStructComparator[employee]()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got := sortWith([]employee{erin, bob, dave, alice}, comparator)
+	expected := []employee{dave, alice, bob, erin}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if _, ok := comparator.(ThreeWayComparator[employee]); !ok {
+		t.Errorf("Expected the struct comparator to implement ThreeWayComparator")
+	}
+}
+
+func TestParseStructComparator(t *testing.T) {
+	comparator, err := ParseStructComparator[employee](" active , DESC ; Level ; SALARY,asc ")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	a := employee{Name: "a", Active: true, Level: 2, Salary: 100}
+	b := employee{Name: "b", Active: true, Level: 2, Salary: 50}
+	c := employee{Name: "c", Active: true, Level: 1, Salary: 300}
+	d := employee{Name: "d", Active: false, Level: 0, Salary: 10}
+
+	got := sortWith([]employee{d, a, c, b}, comparator)
+	expected := []employee{c, b, a, d}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestParseStructComparatorWithPromotedField(t *testing.T) {
+	older := document{Audit{time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)}, "older"}
+	newer := document{Audit{time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)}, "newer"}
+
+	comparator, err := ParseStructComparator[document]("created,desc")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got := sortWith([]document{older, newer}, comparator)
+	expected := []document{newer, older}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	// auditInfo is unexported, so its promoted fields cannot be read through reflection
+	if _, err := ParseStructComparator[draft]("created"); !errors.Is(err, ErrUnsupportedSortField) {
+		t.Errorf("Expected %v, but got %v", ErrUnsupportedSortField, err)
+	}
+}
+
+func TestStructComparatorErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		build    func() error
+		expected error
+	}{
+		{"not a struct", func() error { _, err := ParseStructComparator[int]("value"); return err }, ErrInvalidSortSpec},
+		{"empty spec", func() error { _, err := ParseStructComparator[employee](" "); return err }, ErrInvalidSortSpec},
+		{"empty term", func() error { _, err := ParseStructComparator[employee]("name;"); return err }, ErrInvalidSortSpec},
+		{"unknown field", func() error { _, err := ParseStructComparator[employee]("age"); return err }, ErrInvalidSortSpec},
+		{"unknown direction", func() error { _, err := ParseStructComparator[employee]("name,up"); return err }, ErrInvalidSortSpec},
+		{"missing tag", func() error { _, err := NewStructComparator[Person](); return err }, ErrInvalidSortSpec},
+		{"slice field", func() error { _, err := ParseStructComparator[unsupportedRecord]("tags"); return err }, ErrUnsupportedSortField},
+		{"unexported field", func() error { _, err := ParseStructComparator[unsupportedRecord]("note"); return err }, ErrUnsupportedSortField},
+		{"through pointer", func() error { _, err := ParseStructComparator[entityRef]("id"); return err }, ErrUnsupportedSortField},
+		{"ambiguous case", func() error { _, err := ParseStructComparator[shoutingRecord]("name"); return err }, ErrInvalidSortSpec},
+	}
+
+	for _, tc := range cases {
+		if err := tc.build(); !errors.Is(err, tc.expected) {
+			t.Errorf("%s: expected %v, but got %v", tc.name, tc.expected, err)
+		}
+	}
+}
+
+func TestStructComparatorPrefersExactFieldName(t *testing.T) {
+	comparator, err := ParseStructComparator[shoutingRecord]("NAME")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	a, b := shoutingRecord{Name: "b", NAME: "A"}, shoutingRecord{Name: "a", NAME: "B"}
+	if !comparator.LessThan(a, b) {
+		t.Errorf("Expected %v to sort before %v by NAME", a, b)
+	}
+
+	_, err = ParseStructComparator[shoutingRecord]("name")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("Expected an ambiguity error, but got %v", err)
+	}
+}
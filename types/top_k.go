@@ -0,0 +1,77 @@
+package types
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// boundedCollector keeps at most k elements of a stream in a heap whose root is the worst element kept, so each new
+// element only has to beat the root to get in.
+type boundedCollector[T any] struct {
+	k    int
+	heap *Heap[T]
+}
+
+func newBoundedCollector[T any](k int, heapType HeapType, comparator Comparator[T]) boundedCollector[T] {
+	k = max(k, 0)
+	return boundedCollector[T]{k: k, heap: NewHeapWithComparator[T](heapType, make([]T, 0, k), comparator)}
+}
+
+// Add offers item to the collector, which keeps it if it ranks among the best k elements seen so far.  On ties the
+// element added first is kept.
+func (c *boundedCollector[T]) Add(item T) {
+	if c.heap.Len() < c.k {
+		c.heap.Push(item)
+		return
+	}
+	// PushPop hands item straight back when it does not beat the worst element kept
+	c.heap.PushPop(item)
+}
+
+// Len returns the number of elements currently kept, which is at most k.
+func (c *boundedCollector[T]) Len() int {
+	return c.heap.Len()
+}
+
+// Results returns the kept elements, best first, without modifying the collector.
+func (c *boundedCollector[T]) Results() []T {
+	worstFirst := NewHeapWithComparator[T](c.heap.heapType, append([]T(nil), c.heap.Data...), c.heap.comparator)
+	results := make([]T, worstFirst.Len())
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i], _ = worstFirst.Pop()
+	}
+	return results
+}
+
+// TopK collects the k largest elements of a stream using O(k) memory.
+type TopK[T any] struct {
+	boundedCollector[T]
+}
+
+// NewTopK creates a collector for the k largest elements using the default comparator.
+func NewTopK[T constraints.Ordered](k int) *TopK[T] {
+	return NewTopKWithComparator[T](k, DefaultComparator[T]{})
+}
+
+// NewTopKWithComparator creates a collector for the k largest elements of any type using a custom comparator.
+// Results are returned in descending order.
+func NewTopKWithComparator[T any](k int, comparator Comparator[T]) *TopK[T] {
+	// a min heap keeps the smallest of the k largest elements at the root, ready to be evicted
+	return &TopK[T]{newBoundedCollector[T](k, MinHeap, comparator)}
+}
+
+// BottomK collects the k smallest elements of a stream using O(k) memory.
+type BottomK[T any] struct {
+	boundedCollector[T]
+}
+
+// NewBottomK creates a collector for the k smallest elements using the default comparator.
+func NewBottomK[T constraints.Ordered](k int) *BottomK[T] {
+	return NewBottomKWithComparator[T](k, DefaultComparator[T]{})
+}
+
+// NewBottomKWithComparator creates a collector for the k smallest elements of any type using a custom comparator.
+// Results are returned in ascending order.
+func NewBottomKWithComparator[T any](k int, comparator Comparator[T]) *BottomK[T] {
+	// a max heap keeps the largest of the k smallest elements at the root, ready to be evicted
+	return &BottomK[T]{newBoundedCollector[T](k, MaxHeap, comparator)}
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestTopK(t *testing.T) {
+	top := NewTopK[int](3)
+	for _, item := range []int{5, 2, 9, 1, 5, 6, 3} {
+		top.Add(item)
+	}
+
+	expected := []int{9, 6, 5}
+	if results := top.Results(); !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, but got %v", expected, results)
+	}
+	if top.Len() != 3 {
+		t.Errorf("Expected 3 elements to be kept, but got %d", top.Len())
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	bottom := NewBottomK[int](3)
+	for _, item := range []int{5, 2, 9, 1, 5, 6, 3} {
+		bottom.Add(item)
+	}
+
+	expected := []int{1, 2, 3}
+	if results := bottom.Results(); !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, but got %v", expected, results)
+	}
+}
+
+func TestTopKFewerElementsThanK(t *testing.T) {
+	top := NewTopK[int](5)
+	top.Add(2)
+	top.Add(7)
+
+	expected := []int{7, 2}
+	if results := top.Results(); !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, but got %v", expected, results)
+	}
+}
+
+func TestTopKZero(t *testing.T) {
+	top := NewTopK[int](0)
+	top.Add(2)
+
+	if results := top.Results(); len(results) != 0 {
+		t.Errorf("Expected no results, but got %v", results)
+	}
+}
+
+func TestTopKResultsAreNonDestructive(t *testing.T) {
+	top := NewTopK[int](2)
+	top.Add(1)
+	top.Add(3)
+	first := top.Results()
+	top.Add(2)
+
+	expected := []int{3, 2}
+	if results := top.Results(); !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, but got %v after %v", expected, results, first)
+	}
+}
+
+func TestTopKWithComparator(t *testing.T) {
+	bob := Person{Name: "Bob", Dob: time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)}
+	alice := Person{Name: "Alice", Dob: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	charlie := Person{Name: "Charlie", Dob: time.Date(2000, time.July, 20, 0, 0, 0, 0, time.UTC)}
+	eve := Person{Name: "Eve", Dob: time.Date(1988, time.April, 10, 0, 0, 0, 0, time.UTC)}
+
+	// the two youngest people are those with the latest dates of birth
+	top := NewTopKWithComparator[Person](2, PersonComparator{})
+	for _, person := range []Person{bob, alice, charlie, eve} {
+		top.Add(person)
+	}
+
+	expected := []Person{charlie, alice}
+	if results := top.Results(); !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected %v, but got %v", expected, results)
+	}
+}
+
+func TestTopKLargeStream(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	stream := make([]int, 10000)
+	top := NewTopK[int](10)
+	for i := range stream {
+		stream[i] = random.Int()
+		top.Add(stream[i])
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(stream)))
+	if results := top.Results(); !reflect.DeepEqual(results, stream[:10]) {
+		t.Errorf("Expected %v, but got %v", stream[:10], results)
+	}
+}
@@ -11,11 +11,29 @@ type Comparator[T any] interface {
 	EqualTo(a, b T) bool
 }
 
+// ThreeWayComparator is a Comparator that can also compare two elements in a single call, returning a negative
+// number, zero or a positive number when a is less than, equal to or greater than b, in the style of cmp.Compare.
+// Use CompareFunc to obtain a three-way comparison from any Comparator.
+type ThreeWayComparator[T any] interface {
+	Comparator[T]
+	Compare(a, b T) int
+}
+
 type DefaultComparator[T constraints.Ordered] struct{}
 
 func (d DefaultComparator[T]) GreaterThan(a, b T) bool { return a > b }
 func (d DefaultComparator[T]) LessThan(a, b T) bool    { return a < b }
 func (d DefaultComparator[T]) EqualTo(a, b T) bool     { return a == b }
+func (d DefaultComparator[T]) Compare(a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
 
 // ===========================================================================
 // 						===== Test Dependencies =====